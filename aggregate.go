@@ -0,0 +1,151 @@
+package loz
+
+import (
+	"cmp"
+
+	. "github.com/jmatth/loz/internal"
+)
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | Float
+}
+
+// Sum consumes the iterator and returns the sum of its elements. If the
+// iterator is empty a zero value will be returned along with an error.
+func Sum[V Number](s Seq[V]) (V, error) {
+	return s.Reduce(func(a, b V) V {
+		return a + b
+	})
+}
+
+// TrySum is identical to [Sum], except it will recover any panic caused by
+// [PanicHaltIteration] and return the wrapped error.
+func TrySum[V Number](s Seq[V]) (result V, err error) {
+	defer RecoverHaltIteration(&err)
+	return Sum(s)
+}
+
+// Average consumes the iterator and returns the arithmetic mean of its
+// elements. If the iterator is empty 0 will be returned along with an error.
+func Average[V Number](s Seq[V]) (float64, error) {
+	var sum float64
+	var count int
+	s(func(v V) bool {
+		sum += float64(v)
+		count++
+		return true
+	})
+	if count == 0 {
+		return 0, EmptySeqErr
+	}
+	return sum / float64(count), nil
+}
+
+// TryAverage is identical to [Average], except it will recover any panic
+// caused by [PanicHaltIteration] and return the wrapped error.
+func TryAverage[V Number](s Seq[V]) (result float64, err error) {
+	defer RecoverHaltIteration(&err)
+	return Average(s)
+}
+
+// Min consumes the iterator and returns its smallest element as determined by
+// the built-in min function. If the iterator is empty a zero value will be
+// returned along with an error.
+func Min[V cmp.Ordered](s Seq[V]) (V, error) {
+	return s.Reduce(func(a, b V) V {
+		return min(a, b)
+	})
+}
+
+// TryMin is identical to [Min], except it will recover any panic caused by
+// [PanicHaltIteration] and return the wrapped error.
+func TryMin[V cmp.Ordered](s Seq[V]) (result V, err error) {
+	defer RecoverHaltIteration(&err)
+	return Min(s)
+}
+
+// Max consumes the iterator and returns its largest element as determined by
+// the built-in max function. If the iterator is empty a zero value will be
+// returned along with an error.
+func Max[V cmp.Ordered](s Seq[V]) (V, error) {
+	return s.Reduce(func(a, b V) V {
+		return max(a, b)
+	})
+}
+
+// TryMax is identical to [Max], except it will recover any panic caused by
+// [PanicHaltIteration] and return the wrapped error.
+func TryMax[V cmp.Ordered](s Seq[V]) (result V, err error) {
+	defer RecoverHaltIteration(&err)
+	return Max(s)
+}
+
+// MinBy consumes the iterator and returns its smallest element according to
+// compare, which should return a negative number when a < b, a positive number
+// when a > b, and zero when a == b, such as [cmp.Compare]. If there are
+// several smallest elements the first one is returned. If the iterator is
+// empty a zero value will be returned along with an error.
+func (s Seq[V]) MinBy(compare func(a, b V) int) (V, error) {
+	return s.Reduce(func(a, b V) V {
+		if compare(b, a) < 0 {
+			return b
+		}
+		return a
+	})
+}
+
+// TryMinBy is identical to [Seq.MinBy], except it will recover any panic
+// caused by [PanicHaltIteration] and return the wrapped error.
+func (s Seq[V]) TryMinBy(compare func(a, b V) int) (result V, err error) {
+	defer RecoverHaltIteration(&err)
+	return s.MinBy(compare)
+}
+
+// MaxBy consumes the iterator and returns its largest element according to
+// compare, which follows the same rules as [Seq.MinBy]. If there are several
+// largest elements the first one is returned. If the iterator is empty a zero
+// value will be returned along with an error.
+func (s Seq[V]) MaxBy(compare func(a, b V) int) (V, error) {
+	return s.Reduce(func(a, b V) V {
+		if compare(b, a) > 0 {
+			return b
+		}
+		return a
+	})
+}
+
+// TryMaxBy is identical to [Seq.MaxBy], except it will recover any panic
+// caused by [PanicHaltIteration] and return the wrapped error.
+func (s Seq[V]) TryMaxBy(compare func(a, b V) int) (result V, err error) {
+	defer RecoverHaltIteration(&err)
+	return s.MaxBy(compare)
+}
+
+// Count consumes the iterator and returns the number of elements it yielded.
+func (s Seq[V]) Count() int {
+	var count int
+	s(func(V) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// TryCount is identical to [Seq.Count], except it will recover any panic
+// caused by [PanicHaltIteration] and return the wrapped error.
+func (s Seq[V]) TryCount() (result int, err error) {
+	defer RecoverHaltIteration(&err)
+	return s.Count(), nil
+}
@@ -0,0 +1,109 @@
+package loz_test
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleSum() {
+	sum, err := loz.Sum(loz.IterSlice([]float64{1.5, 2, 3.25}))
+	fmt.Printf("%v, %v\n", sum, err)
+	sum, err = loz.Sum(loz.IterSlice([]float64{}))
+	fmt.Printf("%v, %v", sum, err)
+	// Output: 6.75, <nil>
+	// 0, empty iterator
+}
+
+func ExampleAverage() {
+	avg, err := loz.Average(loz.IterSlice([]int{1, 2, 3, 4}))
+	fmt.Printf("%v, %v\n", avg, err)
+	avg, err = loz.Average(loz.IterSlice([]int{}))
+	fmt.Printf("%v, %v", avg, err)
+	// Output: 2.5, <nil>
+	// 0, empty iterator
+}
+
+func ExampleMin() {
+	smallest, err := loz.Min(loz.IterSlice([]string{"pear", "apple", "fig"}))
+	fmt.Printf("%v, %v", smallest, err)
+	// Output: apple, <nil>
+}
+
+func ExampleMax() {
+	largest, err := loz.Max(loz.IterSlice([]int{3, 9, 2}))
+	fmt.Printf("%v, %v", largest, err)
+	// Output: 9, <nil>
+}
+
+func ExampleSeq_MinBy() {
+	byLen := func(a, b string) int { return cmp.Compare(len(a), len(b)) }
+	shortest, err := loz.IterSlice([]string{"pear", "fig", "apple", "kiwi", "yam"}).MinBy(byLen)
+	fmt.Printf("%v, %v", shortest, err)
+	// Output: fig, <nil>
+}
+
+func ExampleSeq_MaxBy() {
+	byLen := func(a, b string) int { return cmp.Compare(len(a), len(b)) }
+	longest, err := loz.IterSlice([]string{"pear", "banana", "fig", "cherry"}).MaxBy(byLen)
+	fmt.Printf("%v, %v", longest, err)
+	// Output: banana, <nil>
+}
+
+func ExampleSeq_Count() {
+	evens := loz.Generate(10, func(idx int) int { return idx }).
+		Filter(func(n int) bool { return n%2 == 0 }).
+		Count()
+	fmt.Print(evens)
+	// Output: 5
+}
+
+func TestAggregatesEmpty(t *testing.T) {
+	empty := loz.IterSlice([]int{})
+	assert.Equal(t, 0, empty.Count())
+	sum, err := loz.Sum(empty)
+	assert.Zero(t, sum)
+	assert.Equal(t, loz.EmptySeqErr, err)
+	_, err = loz.Min(empty)
+	assert.Equal(t, loz.EmptySeqErr, err)
+	_, err = loz.Max(empty)
+	assert.Equal(t, loz.EmptySeqErr, err)
+	_, err = empty.MinBy(cmp.Compare[int])
+	assert.Equal(t, loz.EmptySeqErr, err)
+	_, err = empty.MaxBy(cmp.Compare[int])
+	assert.Equal(t, loz.EmptySeqErr, err)
+}
+
+func TestAggregateTryMethods(t *testing.T) {
+	seq := loz.Generate(5, func(idx int) int { return idx })
+	haltingErr := errors.New("Testing error")
+	haltingSeq := seq.Map(func(i int) int {
+		loz.PanicHaltIteration(haltingErr)
+		return i
+	})
+	table := []struct {
+		name string
+		run  func(loz.Seq[int]) error
+	}{
+		{"TrySum", func(s loz.Seq[int]) error { _, err := loz.TrySum(s); return err }},
+		{"TryAverage", func(s loz.Seq[int]) error { _, err := loz.TryAverage(s); return err }},
+		{"TryMin", func(s loz.Seq[int]) error { _, err := loz.TryMin(s); return err }},
+		{"TryMax", func(s loz.Seq[int]) error { _, err := loz.TryMax(s); return err }},
+		{"TryMinBy", func(s loz.Seq[int]) error { _, err := s.TryMinBy(cmp.Compare[int]); return err }},
+		{"TryMaxBy", func(s loz.Seq[int]) error { _, err := s.TryMaxBy(cmp.Compare[int]); return err }},
+		{"TryCount", func(s loz.Seq[int]) error { _, err := s.TryCount(); return err }},
+	}
+
+	for _, row := range table {
+		t.Run(row.name, func(t *testing.T) {
+			err := row.run(seq)
+			assert.Nil(t, err)
+			err = row.run(haltingSeq)
+			assert.Equal(t, err, haltingErr)
+		})
+	}
+}
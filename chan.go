@@ -0,0 +1,88 @@
+package loz
+
+import (
+	"sync"
+
+	. "github.com/jmatth/loz/internal"
+)
+
+// FromChan creates a Seq over the values received from a channel. The
+// iteration ends when the channel is closed or the consumer stops early.
+func FromChan[V any](ch <-chan V) Seq[V] {
+	return func(yield Yielder[V]) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FromKVChan creates a KVSeq over the pairs received from a channel. The
+// iteration ends when the channel is closed or the consumer stops early.
+func FromKVChan[K, V any](ch <-chan Pair[K, V]) KVSeq[K, V] {
+	return func(yield Yielder2[K, V]) {
+		for p := range ch {
+			if !yield(p.First, p.Second) {
+				return
+			}
+		}
+	}
+}
+
+// ToChan consumes the iterator in a new goroutine, sending each element to the
+// returned channel, which has the given buffer size and is closed once the
+// iteration completes. The returned stop function must be called once the
+// consumer is finished with the channel, even if it was drained completely. It
+// stops the goroutine if it is still running and waits for it to exit. If the
+// iteration was halted by [PanicHaltIteration] the wrapped error is returned,
+// and any other panic is re-raised on the goroutine calling stop.
+func (s Seq[V]) ToChan(buffer int) (_ <-chan V, stop func() error) {
+	ch := make(chan V, max(buffer, 0))
+	return ch, sendToChan(s, ch)
+}
+
+// ToChan consumes the iterator in a new goroutine, sending each key/value pair
+// to the returned channel. See [Seq.ToChan] for details.
+func (s KVSeq[K, V]) ToChan(buffer int) (_ <-chan Pair[K, V], stop func() error) {
+	ch := make(chan Pair[K, V], max(buffer, 0))
+	return ch, sendToChan(func(yield Yielder[Pair[K, V]]) {
+		s(func(k K, v V) bool {
+			return yield(Pair[K, V]{k, v})
+		})
+	}, ch)
+}
+
+func sendToChan[V any](s func(Yielder[V]), ch chan<- V) func() error {
+	done := make(chan struct{})
+	finished := make(chan struct{})
+	var panicVal any
+	go func() {
+		defer close(finished)
+		defer close(ch)
+		defer func() {
+			panicVal = recover()
+		}()
+		s(func(v V) bool {
+			select {
+			case ch <- v:
+				return true
+			case <-done:
+				return false
+			}
+		})
+	}()
+
+	var once sync.Once
+	return func() (err error) {
+		once.Do(func() {
+			close(done)
+			<-finished
+			defer RecoverHaltIteration(&err)
+			if panicVal != nil {
+				panic(panicVal)
+			}
+		})
+		return err
+	}
+}
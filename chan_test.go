@@ -0,0 +1,93 @@
+package loz_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleFromChan() {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	doubled := loz.FromChan(ch).
+		Map(func(n int) int { return n * 2 }).
+		CollectSlice()
+	fmt.Print(doubled)
+	// Output: [2 4 6]
+}
+
+func ExampleFromKVChan() {
+	ch := make(chan loz.Pair[string, int], 2)
+	ch <- loz.Pair[string, int]{First: "one", Second: 1}
+	ch <- loz.Pair[string, int]{First: "two", Second: 2}
+	close(ch)
+	loz.FromKVChan(ch).ForEach(func(k string, v int) {
+		fmt.Printf("%v: %v\n", k, v)
+	})
+	// Output: one: 1
+	// two: 2
+}
+
+func ExampleSeq_ToChan() {
+	ch, stop := loz.Generate(3, func(idx int) int { return idx }).ToChan(0)
+	defer stop()
+	for n := range ch {
+		fmt.Print(n)
+	}
+	// Output: 012
+}
+
+func ExampleKVSeq_ToChan() {
+	ch, stop := loz.IterSlice([]string{"zero", "one"}).Indexed().ToChan(1)
+	defer stop()
+	for p := range ch {
+		fmt.Printf("%v: %v\n", p.First, p.Second)
+	}
+	// Output: 0: zero
+	// 1: one
+}
+
+func TestToChanStopEarly(t *testing.T) {
+	generated := 0
+	ch, stop := loz.Generate(1_000, func(idx int) int {
+		generated++
+		return idx
+	}).ToChan(0)
+	assert.Equal(t, 0, <-ch)
+	assert.Equal(t, 1, <-ch)
+	assert.Nil(t, stop())
+	assert.Less(t, generated, 5)
+	_, ok := <-ch
+	assert.False(t, ok)
+}
+
+func TestToChanHalt(t *testing.T) {
+	haltingErr := errors.New("Testing error")
+	ch, stop := loz.Generate(5, func(idx int) int {
+		if idx == 2 {
+			loz.PanicHaltIteration(haltingErr)
+		}
+		return idx
+	}).ToChan(5)
+	received := loz.FromChan(ch).CollectSlice()
+	assert.Equal(t, []int{0, 1}, received)
+	assert.Equal(t, haltingErr, stop())
+	assert.Nil(t, stop())
+}
+
+func TestToChanRepanics(t *testing.T) {
+	ch, stop := loz.Generate(5, func(idx int) int {
+		panic("boom")
+	}).ToChan(0)
+	for range ch {
+	}
+	assert.PanicsWithValue(t, "boom", func() {
+		_ = stop()
+	})
+}
@@ -0,0 +1,80 @@
+package loz
+
+import (
+	"slices"
+
+	. "github.com/jmatth/loz/internal"
+)
+
+// Chunk creates a Seq that yields the elements of s in consecutive batches of
+// size n. Every batch has exactly n elements except the last, which may be
+// smaller. Each batch is a newly allocated slice, so it is safe to retain. Chunk
+// panics if n is less than 1.
+//
+// Due to limitations of the Go type system this cannot be a method on [Seq].
+// To map the batches within a chain of [Map1], [Map2], etc., see their
+// MapChunks method.
+func Chunk[V any](s Seq[V], n int) Seq[[]V] {
+	if n < 1 {
+		panic("loz.Chunk: n cannot be less than 1")
+	}
+	return func(yield Yielder[[]V]) {
+		var chunk []V
+		stopped := false
+		s(func(v V) bool {
+			chunk = append(chunk, v)
+			if len(chunk) < n {
+				return true
+			}
+			if !yield(chunk) {
+				stopped = true
+				return false
+			}
+			chunk = nil
+			return true
+		})
+		if !stopped && len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Window creates a Seq that yields windows of size consecutive elements of s,
+// starting a new window every step elements. If step is smaller than size the
+// windows overlap, and if it is larger some elements are skipped. Only full
+// windows are yielded. Each window is a newly allocated slice, so it is safe to
+// retain. Window panics if size or step is less than 1.
+//
+// Due to limitations of the Go type system this cannot be a method on [Seq].
+// To map the windows within a chain of [Map1], [Map2], etc., see their
+// MapWindows method.
+func Window[V any](s Seq[V], size, step int) Seq[[]V] {
+	if size < 1 {
+		panic("loz.Window: size cannot be less than 1")
+	}
+	if step < 1 {
+		panic("loz.Window: step cannot be less than 1")
+	}
+	return func(yield Yielder[[]V]) {
+		var buf []V
+		var toSkip int
+		s(func(v V) bool {
+			if toSkip > 0 {
+				toSkip--
+				return true
+			}
+			buf = append(buf, v)
+			if len(buf) < size {
+				return true
+			}
+			window := slices.Clone(buf)
+			if step < size {
+				buf = append(buf[:0], buf[step:]...)
+			} else {
+				buf = buf[:0]
+				toSkip = step - size
+			}
+			return yield(window)
+		})
+	}
+}
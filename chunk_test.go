@@ -0,0 +1,57 @@
+package loz_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleChunk() {
+	batches := loz.Chunk(loz.Generate(7, func(idx int) int { return idx }), 3).
+		CollectSlice()
+	fmt.Print(batches)
+	// Output: [[0 1 2] [3 4 5] [6]]
+}
+
+func ExampleWindow() {
+	windows := loz.Window(loz.IterSlice([]int{1, 2, 3, 4, 5}), 3, 1).
+		CollectSlice()
+	fmt.Print(windows)
+	// Output: [[1 2 3] [2 3 4] [3 4 5]]
+}
+
+func TestChunk(t *testing.T) {
+	nums := loz.Generate(6, func(idx int) int { return idx })
+	assert.Equal(t, [][]int{{0, 1, 2}, {3, 4, 5}}, loz.Chunk(nums, 3).CollectSlice())
+	assert.Equal(t, [][]int{{0, 1}, {2, 3}}, loz.Chunk(nums, 2).Take(2).CollectSlice())
+	assert.Empty(t, loz.Chunk(loz.IterSlice([]int{}), 3).CollectSlice())
+	assert.PanicsWithValue(t, "loz.Chunk: n cannot be less than 1", func() { loz.Chunk(nums, 0) })
+}
+
+func TestChunksAreIndependent(t *testing.T) {
+	chunks := loz.Chunk(loz.Generate(4, func(idx int) int { return idx }), 2).CollectSlice()
+	chunks[0] = append(chunks[0], 100)
+	assert.Equal(t, []int{2, 3}, chunks[1])
+}
+
+func TestWindow(t *testing.T) {
+	nums := loz.Generate(7, func(idx int) int { return idx })
+	assert.Equal(t, [][]int{{0, 1, 2}, {2, 3, 4}, {4, 5, 6}}, loz.Window(nums, 3, 2).CollectSlice())
+	assert.Equal(t, [][]int{{0, 1}, {3, 4}}, loz.Window(nums, 2, 3).CollectSlice())
+	assert.Equal(t, [][]int{{0, 1, 2}}, loz.Window(nums, 3, 1).Take(1).CollectSlice())
+	assert.Empty(t, loz.Window(nums, 8, 1).CollectSlice())
+	assert.PanicsWithValue(t, "loz.Window: size cannot be less than 1", func() { loz.Window(nums, 0, 1) })
+	assert.PanicsWithValue(t, "loz.Window: step cannot be less than 1", func() { loz.Window(nums, 2, 0) })
+}
+
+func TestChunkAndWindowLargeSizes(t *testing.T) {
+	nums := loz.IterSlice([]int{1, 2})
+	for _, n := range []int{1 << 30, math.MaxInt} {
+		assert.Equal(t, [][]int{{1, 2}}, loz.Chunk(nums, n).CollectSlice())
+		assert.Empty(t, loz.Window(nums, n, 1).CollectSlice())
+		assert.Equal(t, [][]int{{1}}, loz.Window(nums, 1, n).CollectSlice())
+	}
+}
@@ -0,0 +1,109 @@
+package loz
+
+import (
+	"iter"
+
+	. "github.com/jmatth/loz/internal"
+)
+
+// Concat creates a Seq that yields all the elements of each of seqs in turn.
+// If the consumer stops early, the remaining inputs are never iterated.
+func Concat[V any](seqs ...Seq[V]) Seq[V] {
+	return func(yield Yielder[V]) {
+		for _, s := range seqs {
+			stopped := false
+			s(func(v V) bool {
+				stopped = !yield(v)
+				return !stopped
+			})
+			if stopped {
+				return
+			}
+		}
+	}
+}
+
+// Chain continues the iteration with the elements of others once the iterator
+// is exhausted. See [Concat].
+func (s Seq[V]) Chain(others ...Seq[V]) Seq[V] {
+	return Concat(append([]Seq[V]{s}, others...)...)
+}
+
+// Interleave creates a Seq that yields one element from each of seqs in
+// round-robin order. Inputs that are exhausted are dropped from the rotation
+// and the iteration continues until all of them are exhausted.
+func Interleave[V any](seqs ...Seq[V]) Seq[V] {
+	return func(yield Yielder[V]) {
+		nexts := make([]func() (V, bool), 0, len(seqs))
+		for _, s := range seqs {
+			next, stop := iter.Pull(iter.Seq[V](s))
+			defer stop()
+			nexts = append(nexts, next)
+		}
+		for len(nexts) > 0 {
+			remaining := nexts[:0]
+			for _, next := range nexts {
+				v, ok := next()
+				if !ok {
+					continue
+				}
+				if !yield(v) {
+					return
+				}
+				remaining = append(remaining, next)
+			}
+			nexts = remaining
+		}
+	}
+}
+
+// ConcatKV creates a KVSeq that yields all the key/value pairs of each of seqs
+// in turn. If the consumer stops early, the remaining inputs are never
+// iterated.
+func ConcatKV[K, V any](seqs ...KVSeq[K, V]) KVSeq[K, V] {
+	return func(yield Yielder2[K, V]) {
+		for _, s := range seqs {
+			stopped := false
+			s(func(k K, v V) bool {
+				stopped = !yield(k, v)
+				return !stopped
+			})
+			if stopped {
+				return
+			}
+		}
+	}
+}
+
+// Chain continues the iteration with the key/value pairs of others once the
+// iterator is exhausted. See [ConcatKV].
+func (s KVSeq[K, V]) Chain(others ...KVSeq[K, V]) KVSeq[K, V] {
+	return ConcatKV(append([]KVSeq[K, V]{s}, others...)...)
+}
+
+// InterleaveKV creates a KVSeq that yields one key/value pair from each of
+// seqs in round-robin order. See [Interleave].
+func InterleaveKV[K, V any](seqs ...KVSeq[K, V]) KVSeq[K, V] {
+	return func(yield Yielder2[K, V]) {
+		nexts := make([]func() (K, V, bool), 0, len(seqs))
+		for _, s := range seqs {
+			next, stop := iter.Pull2(iter.Seq2[K, V](s))
+			defer stop()
+			nexts = append(nexts, next)
+		}
+		for len(nexts) > 0 {
+			remaining := nexts[:0]
+			for _, next := range nexts {
+				k, v, ok := next()
+				if !ok {
+					continue
+				}
+				if !yield(k, v) {
+					return
+				}
+				remaining = append(remaining, next)
+			}
+			nexts = remaining
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package loz_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleConcat() {
+	all := loz.Concat(
+		loz.IterSlice([]int{1, 2}),
+		loz.IterSlice([]int{}),
+		loz.IterSlice([]int{3}),
+	).CollectSlice()
+	fmt.Print(all)
+	// Output: [1 2 3]
+}
+
+func ExampleSeq_Chain() {
+	all := loz.IterSlice([]string{"a", "b"}).
+		Chain(loz.IterSlice([]string{"c"}), loz.IterSlice([]string{"d"})).
+		CollectSlice()
+	fmt.Print(all)
+	// Output: [a b c d]
+}
+
+func ExampleInterleave() {
+	mixed := loz.Interleave(
+		loz.IterSlice([]string{"a1", "a2", "a3"}),
+		loz.IterSlice([]string{"b1"}),
+		loz.IterSlice([]string{"c1", "c2"}),
+	).CollectSlice()
+	fmt.Print(mixed)
+	// Output: [a1 b1 c1 a2 c2 a3]
+}
+
+func ExampleConcatKV() {
+	loz.ConcatKV(
+		iterKVPairs[int, string](1, "one"),
+		iterKVPairs[int, string](2, "two", 3, "three"),
+	).ForEach(func(k int, v string) {
+		fmt.Printf("%v: %v\n", k, v)
+	})
+	// Output: 1: one
+	// 2: two
+	// 3: three
+}
+
+func ExampleKVSeq_Chain() {
+	loz.IterSlice([]string{"zero"}).
+		Indexed().
+		Chain(iterKVPairs[int, string](10, "ten")).
+		ForEach(func(k int, v string) {
+			fmt.Printf("%v: %v\n", k, v)
+		})
+	// Output: 0: zero
+	// 10: ten
+}
+
+func ExampleInterleaveKV() {
+	loz.InterleaveKV(
+		iterKVPairs[string, int]("a", 1, "a", 2),
+		iterKVPairs[string, int]("b", 1, "b", 2),
+	).ForEach(func(k string, v int) {
+		fmt.Printf("%v%v ", k, v)
+	})
+	// Output: a1 b1 a2 b2
+}
+
+func TestConcatStopsRemainingSources(t *testing.T) {
+	pulled := 0
+	counting := loz.Generate(5, func(idx int) int {
+		pulled++
+		return idx
+	})
+	result := loz.Concat(loz.IterSlice([]int{-2, -1}), counting, counting).
+		Take(3).
+		CollectSlice()
+	assert.Equal(t, []int{-2, -1, 0}, result)
+	assert.Equal(t, 2, pulled)
+
+	pulled = 0
+	result = loz.Interleave(counting, counting).Take(3).CollectSlice()
+	assert.Equal(t, []int{0, 0, 1}, result)
+	assert.Equal(t, 4, pulled)
+}
@@ -0,0 +1,67 @@
+package loz
+
+import . "github.com/jmatth/loz/internal"
+
+// CompKKVSeq is a [KVSeq] whose keys are comparable, which allows it to
+// provide additional methods such as collecting the key/value pairs into a map.
+// Any KVSeq with comparable keys can be converted directly to a CompKKVSeq.
+type CompKKVSeq[K comparable, V any] KVSeq[K, V]
+
+// CollectMap collects all the key/value pairs within the iterator into a map.
+// If a key is yielded more than once the last value wins. To handle duplicate
+// keys differently, see [CompKKVSeq.CollectMapFunc].
+func (s CompKKVSeq[K, V]) CollectMap() map[K]V {
+	result := make(map[K]V)
+	s(func(k K, v V) bool {
+		result[k] = v
+		return true
+	})
+	return result
+}
+
+// TryCollectMap is identical to [CompKKVSeq.CollectMap], except it will
+// recover any panic caused by [PanicHaltIteration] and return the wrapped
+// error.
+func (s CompKKVSeq[K, V]) TryCollectMap() (result map[K]V, err error) {
+	defer RecoverHaltIteration(&err)
+	return s.CollectMap(), nil
+}
+
+// CollectMapFunc collects all the key/value pairs within the iterator into a
+// map. If a key is yielded more than once, merge is called with the value
+// already in the map and the new value, and its result is stored instead.
+func (s CompKKVSeq[K, V]) CollectMapFunc(merge Reducer[V, V]) map[K]V {
+	result := make(map[K]V)
+	s(func(k K, v V) bool {
+		if existing, ok := result[k]; ok {
+			v = merge(existing, v)
+		}
+		result[k] = v
+		return true
+	})
+	return result
+}
+
+// TryCollectMapFunc is identical to [CompKKVSeq.CollectMapFunc], except it
+// will recover any panic caused by [PanicHaltIteration] and return the wrapped
+// error.
+func (s CompKKVSeq[K, V]) TryCollectMapFunc(merge Reducer[V, V]) (result map[K]V, err error) {
+	defer RecoverHaltIteration(&err)
+	return s.CollectMapFunc(merge), nil
+}
+
+// DistinctKeys restricts the iterator to the first key/value pair for each
+// distinct key. Every distinct key is held in memory for the duration of the
+// iteration.
+func (s CompKKVSeq[K, V]) DistinctKeys() KVSeq[K, V] {
+	return func(yield Yielder2[K, V]) {
+		seen := make(map[K]struct{})
+		s(func(k K, v V) bool {
+			if _, ok := seen[k]; ok {
+				return true
+			}
+			seen[k] = struct{}{}
+			return yield(k, v)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package loz
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"slices"
+
+	. "github.com/jmatth/loz/internal"
+)
+
+// CSVRecords creates a Seq over the records read from a [csv.Reader]. If a
+// record cannot be read the iteration is halted as if the error was passed to
+// [PanicHaltIteration], so the result should be consumed with a terminal
+// method prefixed with "Try", such as [Seq.TryCollectSlice], which will return
+// the error. Parse errors are returned as a [*csv.ParseError], which includes
+// the line and column where the error occurred. If the reader's ReuseRecord
+// field is set, records must be copied before being retained.
+func CSVRecords(r *csv.Reader) Seq[[]string] {
+	return func(yield Yielder[[]string]) {
+		for {
+			record, err := r.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			PanicHaltIteration(err)
+			if !yield(record) {
+				return
+			}
+		}
+	}
+}
+
+// CSVRows creates a KVSeq over the records read from a [csv.Reader], using the
+// first record as a header. Each following record is yielded as a map from
+// the header's column names to its fields, keyed by its index among the
+// records after the header. Errors are reported the same as [CSVRecords].
+func CSVRows(r *csv.Reader) KVSeq[int, map[string]string] {
+	return func(yield Yielder2[int, map[string]string]) {
+		header, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		PanicHaltIteration(err)
+		header = slices.Clone(header)
+		CSVRecords(r).Indexed()(func(i int, record []string) bool {
+			row := make(map[string]string, len(header))
+			for col, name := range header {
+				if col < len(record) {
+					row[name] = record[col]
+				}
+			}
+			return yield(i, row)
+		})
+	}
+}
+
+// WriteCSV writes the records of s to a [csv.Writer] and flushes it once s is
+// exhausted. The first write error halts the iteration and is returned, as is
+// any error passed to [PanicHaltIteration] during the iteration.
+func WriteCSV(w *csv.Writer, s Seq[[]string]) error {
+	err := s.TryForEach(func(record []string) {
+		PanicHaltIteration(w.Write(record))
+	})
+	w.Flush()
+	if err != nil {
+		return err
+	}
+	return w.Error()
+}
@@ -0,0 +1,87 @@
+package loz_test
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+const usersCSV = `id,name,shell
+0,root,/bin/bash
+1000,josh,/bin/zsh
+1001,katie,/usr/bin/fish
+`
+
+func ExampleCSVRecords() {
+	records, err := loz.CSVRecords(csv.NewReader(strings.NewReader(usersCSV))).
+		Skip(1).
+		TryCollectSlice()
+	fmt.Printf("%q; %v", records, err)
+	// Output: [["0" "root" "/bin/bash"] ["1000" "josh" "/bin/zsh"] ["1001" "katie" "/usr/bin/fish"]]; <nil>
+}
+
+func ExampleCSVRows() {
+	err := loz.CSVRows(csv.NewReader(strings.NewReader(usersCSV))).
+		Filter(func(_ int, row map[string]string) bool { return row["id"] != "0" }).
+		TryForEach(func(i int, row map[string]string) {
+			fmt.Printf("%v: %v uses %v\n", i, row["name"], row["shell"])
+		})
+	fmt.Print(err)
+	// Output: 1: josh uses /bin/zsh
+	// 2: katie uses /usr/bin/fish
+	// <nil>
+}
+
+func ExampleWriteCSV() {
+	records := loz.IterSlice([][]string{{"id", "name"}, {"0", "root"}, {"1000", "josh, jr."}})
+	err := loz.WriteCSV(csv.NewWriter(os.Stdout), records)
+	fmt.Print(err)
+	// Output: id,name
+	// 0,root
+	// 1000,"josh, jr."
+	// <nil>
+}
+
+func TestCSVParseErrorLine(t *testing.T) {
+	input := "id,name\n0,root\n1000,josh,extra\n"
+	rows, err := loz.CSVRows(csv.NewReader(strings.NewReader(input))).Values().TryCollectSlice()
+	assert.Nil(t, rows)
+	var parseErr *csv.ParseError
+	assert.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, 3, parseErr.Line)
+	assert.ErrorIs(t, err, csv.ErrFieldCount)
+
+	_, err = loz.CSVRecords(csv.NewReader(strings.NewReader("a,b\n\"unterminated\n"))).TryCollectSlice()
+	assert.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, 2, parseErr.StartLine)
+}
+
+func TestCSVEmpty(t *testing.T) {
+	rows, err := loz.CSVRows(csv.NewReader(strings.NewReader(""))).Values().TryCollectSlice()
+	assert.Empty(t, rows)
+	assert.Nil(t, err)
+}
+
+func TestWriteCSVErrors(t *testing.T) {
+	writeErr := errors.New("Testing error")
+	err := loz.WriteCSV(csv.NewWriter(&failingWriter{limit: 0, err: writeErr}),
+		loz.IterSlice([][]string{{"a"}}))
+	assert.Equal(t, writeErr, err)
+
+	haltingErr := errors.New("halting error")
+	var sb strings.Builder
+	err = loz.WriteCSV(csv.NewWriter(&sb), loz.Generate(3, func(idx int) []string {
+		if idx == 1 {
+			loz.PanicHaltIteration(haltingErr)
+		}
+		return []string{fmt.Sprint(idx)}
+	}))
+	assert.Equal(t, haltingErr, err)
+	assert.Equal(t, "0\n", sb.String())
+}
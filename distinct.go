@@ -0,0 +1,55 @@
+package loz
+
+import . "github.com/jmatth/loz/internal"
+
+// Distinct restricts the iterator to the first occurrence of each element.
+// Every distinct element is held in memory for the duration of the iteration;
+// for input that is already sorted, [Dedup] does the same without the memory
+// cost.
+func Distinct[V comparable](s Seq[V]) Seq[V] {
+	return DistinctBy(s, func(v V) V { return v })
+}
+
+// DistinctBy restricts the iterator to the first element for each distinct
+// key returned by toKey. Every distinct key is held in memory for the duration
+// of the iteration.
+//
+// Due to limitations of the Go type system this cannot be a method on [Seq] or
+// [Map1], since the key type must be comparable.
+func DistinctBy[V any, K comparable](s Seq[V], toKey Mapper[V, K]) Seq[V] {
+	return func(yield Yielder[V]) {
+		seen := make(map[K]struct{})
+		s(func(v V) bool {
+			k := toKey(v)
+			if _, ok := seen[k]; ok {
+				return true
+			}
+			seen[k] = struct{}{}
+			return yield(v)
+		})
+	}
+}
+
+// Dedup removes consecutive duplicate elements from the iterator, keeping the
+// first of each run. Only the previous element is held in memory, so this
+// removes all duplicates only if equal elements are adjacent, such as in
+// sorted input.
+func Dedup[V comparable](s Seq[V]) Seq[V] {
+	return s.DedupFunc(func(a, b V) bool { return a == b })
+}
+
+// DedupFunc is identical to [Dedup], except elements are compared using the
+// provided function instead of ==.
+func (s Seq[V]) DedupFunc(equal func(a, b V) bool) Seq[V] {
+	return func(yield Yielder[V]) {
+		var prev V
+		isFirst := true
+		s(func(v V) bool {
+			if !isFirst && equal(prev, v) {
+				return true
+			}
+			prev, isFirst = v, false
+			return yield(v)
+		})
+	}
+}
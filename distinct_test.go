@@ -0,0 +1,58 @@
+package loz_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleDistinct() {
+	ids := loz.Distinct(loz.IterSlice([]int{3, 1, 3, 2, 1, 4})).CollectSlice()
+	fmt.Print(ids)
+	// Output: [3 1 2 4]
+}
+
+func ExampleDistinctBy() {
+	words := loz.DistinctBy(loz.IterSlice([]string{"Go", "go", "Rust", "GO", "rust", "Dart"}),
+		strings.ToLower).
+		CollectSlice()
+	fmt.Print(words)
+	// Output: [Go Rust Dart]
+}
+
+func ExampleDedup() {
+	sorted := loz.Dedup(loz.IterSlice([]int{1, 1, 2, 3, 3, 3, 4, 1})).CollectSlice()
+	fmt.Print(sorted)
+	// Output: [1 2 3 4 1]
+}
+
+func ExampleSeq_DedupFunc() {
+	words := loz.IterSlice([]string{"go", "Go", "rust", "GO"}).
+		DedupFunc(strings.EqualFold).
+		CollectSlice()
+	fmt.Print(words)
+	// Output: [go rust GO]
+}
+
+func ExampleCompKKVSeq_DistinctKeys() {
+	loz.CompKKVSeq[int, string](iterKVPairs[int, string](1, "one", 2, "two", 1, "uno", 3, "three")).
+		DistinctKeys().
+		ForEach(func(k int, v string) {
+			fmt.Printf("%v: %v\n", k, v)
+		})
+	// Output: 1: one
+	// 2: two
+	// 3: three
+}
+
+func TestDistinctExpand(t *testing.T) {
+	ids := loz.IterSlice([]int{1, 2, 3}).
+		Expand(func(n int) loz.Seq[int] {
+			return loz.Generate(n, func(idx int) int { return idx })
+		})
+	assert.Equal(t, []int{0, 1, 2}, loz.Distinct(ids).CollectSlice())
+	assert.Equal(t, []int{0, 1}, loz.Distinct(ids).Take(2).CollectSlice())
+}
@@ -0,0 +1,55 @@
+package loz
+
+import (
+	"io/fs"
+
+	. "github.com/jmatth/loz/internal"
+)
+
+// WalkDir creates a KVSeq over the file tree rooted at root, yielding the path
+// and [fs.DirEntry] of each file or directory in the same lexical order as
+// [fs.WalkDir], including root itself. If an error occurs while walking the
+// tree the iteration is halted as if the error was passed to
+// [PanicHaltIteration], so the result should be consumed with a terminal
+// method prefixed with "Try", such as [KVSeq.TryForEach], which will return
+// the error. To skip parts of the tree, see [WalkDirPrune].
+func WalkDir(fsys fs.FS, root string) KVSeq[string, fs.DirEntry] {
+	return WalkDirPrune(fsys, root, func(string, fs.DirEntry) bool { return false })
+}
+
+// WalkDirPrune is identical to [WalkDir], except entries for which prune
+// returns true are not yielded. If a pruned entry is a directory its contents
+// are skipped as well, similar to returning [fs.SkipDir] from an
+// [fs.WalkDirFunc].
+func WalkDirPrune(fsys fs.FS, root string, prune Yielder2[string, fs.DirEntry]) KVSeq[string, fs.DirEntry] {
+	return func(yield Yielder2[string, fs.DirEntry]) {
+		_ = fs.WalkDir(fsys, root, func(path string, d fs.DirEntry, err error) error {
+			PanicHaltIteration(err)
+			if prune(path, d) {
+				if d.IsDir() {
+					return fs.SkipDir
+				}
+				return nil
+			}
+			if !yield(path, d) {
+				return fs.SkipAll
+			}
+			return nil
+		})
+	}
+}
+
+// Glob creates a Seq over the names in fsys that match pattern, using the same
+// syntax and ordering as [fs.Glob]. If pattern is malformed the iteration is
+// halted as if [path.ErrBadPattern] was passed to [PanicHaltIteration].
+func Glob(fsys fs.FS, pattern string) Seq[string] {
+	return func(yield Yielder[string]) {
+		matches, err := fs.Glob(fsys, pattern)
+		PanicHaltIteration(err)
+		for _, m := range matches {
+			if !yield(m) {
+				return
+			}
+		}
+	}
+}
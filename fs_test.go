@@ -0,0 +1,72 @@
+package loz_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"testing"
+	"testing/fstest"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+var testFS = fstest.MapFS{
+	"go.mod":               {Data: []byte("module example")},
+	"main.go":              {Data: []byte("package main")},
+	"internal/util.go":     {Data: []byte("package internal")},
+	"vendor/dep/dep.go":    {Data: []byte("package dep")},
+	"docs/README.md":       {Data: []byte("# docs")},
+	"docs/images/logo.png": {Data: []byte{}},
+}
+
+func ExampleWalkDir() {
+	err := loz.WalkDir(testFS, ".").
+		Filter(func(_ string, d fs.DirEntry) bool { return !d.IsDir() }).
+		TryForEach(func(p string, _ fs.DirEntry) {
+			fmt.Println(p)
+		})
+	fmt.Print(err)
+	// Output: docs/README.md
+	// docs/images/logo.png
+	// go.mod
+	// internal/util.go
+	// main.go
+	// vendor/dep/dep.go
+	// <nil>
+}
+
+func ExampleWalkDirPrune() {
+	goFiles := loz.WalkDirPrune(testFS, ".", func(p string, d fs.DirEntry) bool {
+		return d.IsDir() && d.Name() == "vendor"
+	}).
+		Keys().
+		Filter(func(p string) bool { return path.Ext(p) == ".go" }).
+		CollectSlice()
+	fmt.Print(goFiles)
+	// Output: [internal/util.go main.go]
+}
+
+func ExampleGlob() {
+	fmt.Print(loz.Glob(testFS, "*/*.go").CollectSlice())
+	// Output: [internal/util.go]
+}
+
+func TestWalkDirErrors(t *testing.T) {
+	err := loz.WalkDir(testFS, "missing").TryForEach(func(string, fs.DirEntry) {})
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+
+	_, err = loz.Glob(testFS, "[").TryCollectSlice()
+	assert.Equal(t, path.ErrBadPattern, err)
+}
+
+func TestWalkDirStopsEarly(t *testing.T) {
+	paths := loz.WalkDir(testFS, ".").Keys().Take(3).CollectSlice()
+	assert.Equal(t, []string{".", "docs", "docs/README.md"}, paths)
+
+	paths = loz.WalkDirPrune(testFS, "docs", func(p string, _ fs.DirEntry) bool {
+		return path.Ext(p) == ".md"
+	}).Keys().CollectSlice()
+	assert.Equal(t, []string{"docs", "docs/images", "docs/images/logo.png"}, paths)
+}
@@ -0,0 +1,79 @@
+package loz
+
+import . "github.com/jmatth/loz/internal"
+
+// GroupBy consumes the iterator and groups its elements into a map by the key
+// returned from toKey. The elements within each group keep their relative
+// order from the input.
+//
+// Due to limitations of the Go type system this cannot be a method on [Seq] or
+// [Map1], since the key type must be comparable.
+func GroupBy[V any, K comparable](s Seq[V], toKey Mapper[V, K]) map[K][]V {
+	result := make(map[K][]V)
+	s(func(v V) bool {
+		k := toKey(v)
+		result[k] = append(result[k], v)
+		return true
+	})
+	return result
+}
+
+// TryGroupBy is identical to [GroupBy], except it will recover any panic
+// caused by [PanicHaltIteration] and return the wrapped error.
+func TryGroupBy[V any, K comparable](s Seq[V], toKey Mapper[V, K]) (result map[K][]V, err error) {
+	defer RecoverHaltIteration(&err)
+	return GroupBy(s, toKey), nil
+}
+
+// Grouped is a lazy version of [GroupBy]. When iterated it consumes s, groups
+// its elements by the key returned from toKey, and then yields each key along
+// with a Seq over its group. The groups are yielded in the order their keys
+// first appeared in the input.
+func Grouped[V any, K comparable](s Seq[V], toKey Mapper[V, K]) KVSeq[K, Seq[V]] {
+	return func(yield Yielder2[K, Seq[V]]) {
+		var keys []K
+		groups := make(map[K][]V)
+		s(func(v V) bool {
+			k := toKey(v)
+			group, ok := groups[k]
+			if !ok {
+				keys = append(keys, k)
+			}
+			groups[k] = append(group, v)
+			return true
+		})
+		for _, k := range keys {
+			if !yield(k, IterSlice(groups[k])) {
+				return
+			}
+		}
+	}
+}
+
+// GroupByAdjacent groups runs of consecutive elements that have the same key
+// returned from toKey, yielding each key along with a Seq over its run as soon
+// as the run ends. Unlike [Grouped] only the current run is held in memory, so
+// a key will be yielded more than once if its elements are not adjacent.
+func GroupByAdjacent[V any, K comparable](s Seq[V], toKey Mapper[V, K]) KVSeq[K, Seq[V]] {
+	return func(yield Yielder2[K, Seq[V]]) {
+		var currentKey K
+		var run []V
+		stopped := false
+		s(func(v V) bool {
+			k := toKey(v)
+			if len(run) > 0 && k != currentKey {
+				if !yield(currentKey, IterSlice(run)) {
+					stopped = true
+					return false
+				}
+				run = nil
+			}
+			currentKey = k
+			run = append(run, v)
+			return true
+		})
+		if !stopped && len(run) > 0 {
+			yield(currentKey, IterSlice(run))
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package loz_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleGroupBy() {
+	byLen := loz.GroupBy(loz.IterSlice([]string{"fig", "pear", "yam", "kiwi", "apple"}),
+		func(s string) int { return len(s) })
+	fmt.Print(byLen)
+	// Output: map[3:[fig yam] 4:[pear kiwi] 5:[apple]]
+}
+
+func ExampleGrouped() {
+	loz.Grouped(loz.IterSlice([]string{"pear", "fig", "kiwi", "apple", "yam"}),
+		func(s string) int { return len(s) }).
+		ForEach(func(n int, words loz.Seq[string]) {
+			fmt.Printf("%v: %v\n", n, words.CollectSlice())
+		})
+	// Output: 4: [pear kiwi]
+	// 3: [fig yam]
+	// 5: [apple]
+}
+
+func ExampleGroupByAdjacent() {
+	loz.GroupByAdjacent(loz.IterSlice([]int{1, 1, 2, 3, 3, 3, 1}),
+		func(n int) int { return n }).
+		ForEach(func(n int, run loz.Seq[int]) {
+			fmt.Printf("%v: %v\n", n, run.Count())
+		})
+	// Output: 1: 2
+	// 2: 1
+	// 3: 3
+	// 1: 1
+}
+
+func TestGroupByAdjacentStopsEarly(t *testing.T) {
+	pulled := 0
+	nums := loz.Generate(30, func(idx int) int {
+		pulled++
+		return idx / 3
+	})
+	keys := loz.GroupByAdjacent(nums, func(n int) int { return n }).
+		Keys().
+		Take(2).
+		CollectSlice()
+	assert.Equal(t, []int{0, 1}, keys)
+	assert.Equal(t, 10, pulled)
+}
+
+func TestTryGroupBy(t *testing.T) {
+	haltingErr := errors.New("Testing error")
+	nums := loz.Generate(4, func(idx int) int { return idx })
+	result, err := loz.TryGroupBy(nums, func(n int) bool { return n%2 == 0 })
+	assert.Nil(t, err)
+	assert.Equal(t, map[bool][]int{true: {0, 2}, false: {1, 3}}, result)
+
+	result, err = loz.TryGroupBy(nums, func(n int) bool {
+		loz.PanicHaltIteration(haltingErr)
+		return true
+	})
+	assert.Nil(t, result)
+	assert.Equal(t, haltingErr, err)
+}
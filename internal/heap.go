@@ -0,0 +1,72 @@
+package internal
+
+// Heap is a binary heap ordered by less, so that Pop always returns the
+// smallest remaining item.
+type Heap[V any] struct {
+	items []V
+	less  func(a, b V) bool
+}
+
+func NewHeap[V any](less func(a, b V) bool) *Heap[V] {
+	return &Heap[V]{less: less}
+}
+
+func (h *Heap[V]) Len() int {
+	return len(h.items)
+}
+
+// Peek returns the smallest item without removing it. It panics if the heap is
+// empty.
+func (h *Heap[V]) Peek() V {
+	return h.items[0]
+}
+
+func (h *Heap[V]) Push(v V) {
+	h.items = append(h.items, v)
+	h.up(len(h.items) - 1)
+}
+
+// Pop removes and returns the smallest item. It panics if the heap is empty.
+func (h *Heap[V]) Pop() V {
+	last := len(h.items) - 1
+	h.items[0], h.items[last] = h.items[last], h.items[0]
+	result := h.items[last]
+	var zero V
+	h.items[last] = zero
+	h.items = h.items[:last]
+	h.down(0)
+	return result
+}
+
+// Fix restores the heap ordering after the item at index i has changed.
+func (h *Heap[V]) Fix(i int) {
+	h.down(i)
+	h.up(i)
+}
+
+func (h *Heap[V]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(h.items[i], h.items[parent]) {
+			return
+		}
+		h.items[i], h.items[parent] = h.items[parent], h.items[i]
+		i = parent
+	}
+}
+
+func (h *Heap[V]) down(i int) {
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(h.items) && h.less(h.items[child], h.items[smallest]) {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return
+		}
+		h.items[i], h.items[smallest] = h.items[smallest], h.items[i]
+		i = smallest
+	}
+}
@@ -0,0 +1,205 @@
+package internal
+
+import (
+	"errors"
+	"sync"
+)
+
+type parallelResult[O any] struct {
+	val      O
+	panicked bool
+	panicVal any
+}
+
+type parallelJob[V, O any] struct {
+	val V
+	out chan parallelResult[O]
+}
+
+func runRecovering[V, O any](v V, mapper Mapper[V, O]) (result parallelResult[O]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = parallelResult[O]{panicked: true, panicVal: r}
+		}
+	}()
+	return parallelResult[O]{val: mapper(v)}
+}
+
+// ParallelMap maps the elements of seq on a pool of worker goroutines while
+// preserving their order. Any panic raised by seq or mapper is re-raised on the
+// consuming goroutine when the element that caused it is reached. All
+// goroutines have exited by the time the returned function returns.
+func ParallelMap[V, O any](seq func(Yielder[V]), workers int, mapper Mapper[V, O]) func(Yielder[O]) {
+	workers = max(workers, 1)
+	return func(yield Yielder[O]) {
+		done := make(chan struct{})
+		jobs := make(chan parallelJob[V, O])
+		pending := make(chan chan parallelResult[O], workers)
+		var wg sync.WaitGroup
+		defer func() {
+			close(done)
+			wg.Wait()
+		}()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(jobs)
+			defer close(pending)
+			defer func() {
+				if r := recover(); r != nil {
+					out := make(chan parallelResult[O], 1)
+					out <- parallelResult[O]{panicked: true, panicVal: r}
+					select {
+					case pending <- out:
+					case <-done:
+					}
+				}
+			}()
+			seq(func(v V) bool {
+				out := make(chan parallelResult[O], 1)
+				select {
+				case jobs <- parallelJob[V, O]{val: v, out: out}:
+				case <-done:
+					return false
+				}
+				select {
+				case pending <- out:
+					return true
+				case <-done:
+					return false
+				}
+			})
+		}()
+
+		for range workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for job := range jobs {
+					job.out <- runRecovering(job.val, mapper)
+				}
+			}()
+		}
+
+		for out := range pending {
+			result := <-out
+			if result.panicked {
+				panic(result.panicVal)
+			}
+			if !yield(result.val) {
+				return
+			}
+		}
+	}
+}
+
+type panicCollector struct {
+	mu     sync.Mutex
+	values []any
+}
+
+func (c *panicCollector) recover(onPanic func()) {
+	if r := recover(); r != nil {
+		c.mu.Lock()
+		c.values = append(c.values, r)
+		c.mu.Unlock()
+		onPanic()
+	}
+}
+
+// repanic re-raises the collected panics. Panics not caused by
+// PanicHaltIteration take priority, otherwise all of the halting errors are
+// joined into a single one.
+func (c *panicCollector) repanic() {
+	var errs []error
+	for _, r := range c.values {
+		wrapped, ok := r.(WrappedSeqError)
+		if !ok {
+			panic(r)
+		}
+		errs = append(errs, wrapped.wrapped)
+	}
+	switch len(errs) {
+	case 0:
+		return
+	case 1:
+		panic(c.values[0])
+	}
+	panic(NewWrappedSeqError(errors.Join(errs...)))
+}
+
+// ParallelFilterMap filters and maps the elements of seq on a pool of worker
+// goroutines, yielding the results in the order they are completed. Once a
+// panic is raised by seq or mapper no further elements are started, and after
+// the in-flight ones finish the panics are re-raised on the consuming goroutine.
+// All goroutines have exited by the time the returned function returns.
+func ParallelFilterMap[V, O any](seq func(Yielder[V]), workers int, mapper FilteringMapper[V, O]) func(Yielder[O]) {
+	workers = max(workers, 1)
+	return func(yield Yielder[O]) {
+		done := make(chan struct{})
+		var cancel sync.Once
+		stop := func() { cancel.Do(func() { close(done) }) }
+		jobs := make(chan V)
+		results := make(chan O)
+		var panics panicCollector
+		var wg sync.WaitGroup
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(jobs)
+			defer panics.recover(stop)
+			seq(func(v V) bool {
+				select {
+				case jobs <- v:
+					return true
+				case <-done:
+					return false
+				}
+			})
+		}()
+
+		for range workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for v := range jobs {
+					select {
+					case <-done:
+						continue
+					default:
+					}
+					func() {
+						defer panics.recover(stop)
+						mapped, ok := mapper(v)
+						if !ok {
+							return
+						}
+						select {
+						case results <- mapped:
+						case <-done:
+						}
+					}()
+				}
+			}()
+		}
+
+		go func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		defer func() {
+			stop()
+			for range results {
+			}
+		}()
+		for o := range results {
+			if !yield(o) {
+				return
+			}
+		}
+		panics.repanic()
+	}
+}
@@ -0,0 +1,141 @@
+package loz
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+
+	. "github.com/jmatth/loz/internal"
+)
+
+// Lines creates a Seq over the lines read from r, with the trailing "\n" or
+// "\r\n" removed. Unlike a default [bufio.Scanner] there is no limit on the
+// length of a line. If reading from r fails the iteration is halted as
+// described in [Scan].
+func Lines(r io.Reader) Seq[string] {
+	return func(yield Yielder[string]) {
+		br := bufio.NewReader(r)
+		for {
+			line, err := br.ReadString('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				PanicHaltIteration(err)
+			}
+			if line == "" {
+				return
+			}
+			line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+			if !yield(line) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Scan creates a Seq over the tokens produced by a [bufio.Scanner]. If the
+// scanner stops with an error the iteration is halted as if the error was
+// passed to [PanicHaltIteration], so the result should be consumed with a
+// terminal method prefixed with "Try", such as [Seq.TryCollectSlice], which
+// will return the error.
+func Scan(scanner *bufio.Scanner) Seq[string] {
+	return func(yield Yielder[string]) {
+		for scanner.Scan() {
+			if !yield(scanner.Text()) {
+				return
+			}
+		}
+		PanicHaltIteration(scanner.Err())
+	}
+}
+
+// ReadChunks creates a Seq over the contents of r in chunks of size bytes.
+// Every chunk has exactly size bytes except the last, which may be smaller.
+// Each chunk is a newly allocated slice, so it is safe to retain. Errors are
+// reported the same as [Scan]. ReadChunks panics if size is less than 1.
+func ReadChunks(r io.Reader, size int) Seq[[]byte] {
+	if size < 1 {
+		panic("loz.ReadChunks: size cannot be less than 1")
+	}
+	return func(yield Yielder[[]byte]) {
+		for {
+			chunk := make([]byte, size)
+			n, err := io.ReadFull(r, chunk)
+			switch {
+			case errors.Is(err, io.EOF):
+				return
+			case errors.Is(err, io.ErrUnexpectedEOF):
+				yield(chunk[:n])
+				return
+			}
+			PanicHaltIteration(err)
+			if !yield(chunk) {
+				return
+			}
+		}
+	}
+}
+
+// WriteTo writes the elements of s to w with sep between each of them,
+// returning the number of bytes written. The output is buffered and flushed
+// once s is exhausted. The first write error halts the iteration and is
+// returned, as is any error passed to [PanicHaltIteration] during the
+// iteration.
+func WriteTo[V ~string | ~[]byte](w io.Writer, s Seq[V], sep string) (int64, error) {
+	return writeSeq(w, s, sep, "")
+}
+
+// WriteLines is identical to [WriteTo], except each element is followed by a
+// newline instead of being separated by one.
+func WriteLines[V ~string | ~[]byte](w io.Writer, s Seq[V]) (int64, error) {
+	return writeSeq(w, s, "", "\n")
+}
+
+func writeSeq[V ~string | ~[]byte](w io.Writer, s Seq[V], sep, term string) (int64, error) {
+	// Count beneath the buffer rather than subtracting what is left in it, as w
+	// may itself be a *bufio.Writer that bufio.NewWriter would hand back.
+	cw := &countingWriter{w: w}
+	bw := bufio.NewWriter(cw)
+	write := func(str string) {
+		_, err := bw.WriteString(str)
+		PanicHaltIteration(err)
+	}
+	isFirst := true
+	err := s.TryForEach(func(v V) {
+		if !isFirst {
+			write(sep)
+		}
+		isFirst = false
+		write(string(v))
+		write(term)
+	})
+	if flushErr := bw.Flush(); err == nil {
+		err = flushErr
+	}
+	return cw.n, err
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// Encoder is implemented by types that serialize values to an underlying
+// stream, such as [encoding/json.Encoder] and [encoding/gob.Encoder].
+type Encoder interface {
+	Encode(v any) error
+}
+
+// EncodeTo consumes the iterator and passes each element to enc. The first
+// encoding error halts the iteration and is returned, as is any error passed to
+// [PanicHaltIteration] during the iteration.
+func (s Seq[V]) EncodeTo(enc Encoder) error {
+	return s.TryForEach(func(v V) {
+		PanicHaltIteration(enc.Encode(v))
+	})
+}
@@ -0,0 +1,149 @@
+package loz_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleLines() {
+	log := strings.NewReader("INFO started\nERROR disk full\nINFO retrying\nERROR disk still full\n")
+	errs, err := loz.Lines(log).
+		Filter(func(line string) bool { return strings.HasPrefix(line, "ERROR") }).
+		TryCollectSlice()
+	fmt.Printf("%q; %v", errs, err)
+	// Output: ["ERROR disk full" "ERROR disk still full"]; <nil>
+}
+
+func ExampleScan() {
+	scanner := bufio.NewScanner(strings.NewReader("the quick  brown\nfox"))
+	scanner.Split(bufio.ScanWords)
+	fmt.Print(loz.Scan(scanner).Count())
+	// Output: 4
+}
+
+func ExampleReadChunks() {
+	chunks := loz.ReadChunks(strings.NewReader("abcdefgh"), 3).CollectSlice()
+	fmt.Printf("%q", chunks)
+	// Output: ["abc" "def" "gh"]
+}
+
+func TestIOSourceErrors(t *testing.T) {
+	readErr := errors.New("Testing error")
+
+	lines, err := loz.Lines(io.MultiReader(strings.NewReader("partial"), iotest.ErrReader(readErr))).TryCollectSlice()
+	assert.Nil(t, lines)
+	assert.Equal(t, readErr, err)
+
+	chunks, err := loz.ReadChunks(iotest.ErrReader(readErr), 4).TryCollectSlice()
+	assert.Nil(t, chunks)
+	assert.Equal(t, readErr, err)
+
+	lines, err = loz.Lines(strings.NewReader("a\nb\nc")).Take(2).TryCollectSlice()
+	assert.Equal(t, []string{"a", "b"}, lines)
+	assert.Nil(t, err)
+}
+
+func TestLinesLong(t *testing.T) {
+	long := strings.Repeat("x", bufio.MaxScanTokenSize*2)
+	lines, err := loz.Lines(strings.NewReader("a\r\n" + long + "\n\nb")).TryCollectSlice()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", long, "", "b"}, lines)
+}
+
+func TestReadChunksExact(t *testing.T) {
+	chunks := loz.ReadChunks(iotest.OneByteReader(strings.NewReader("abcdef")), 3).CollectSlice()
+	assert.Equal(t, [][]byte{[]byte("abc"), []byte("def")}, chunks)
+	assert.Empty(t, loz.ReadChunks(strings.NewReader(""), 3).CollectSlice())
+	assert.PanicsWithValue(t, "loz.ReadChunks: size cannot be less than 1", func() { loz.ReadChunks(strings.NewReader(""), 0) })
+}
+
+type failingWriter struct {
+	limit int
+	err   error
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		return w.limit, w.err
+	}
+	w.limit -= len(p)
+	return len(p), nil
+}
+
+func ExampleWriteTo() {
+	var sb strings.Builder
+	n, err := loz.WriteTo(&sb, loz.IterSlice([]string{"a", "b", "c"}), ", ")
+	fmt.Printf("%q; %v; %v", sb.String(), n, err)
+	// Output: "a, b, c"; 7; <nil>
+}
+
+func ExampleWriteLines() {
+	var sb strings.Builder
+	_, err := loz.WriteLines(&sb, loz.IterSlice([][]byte{[]byte("one"), []byte("two")}))
+	fmt.Printf("%q; %v", sb.String(), err)
+	// Output: "one\ntwo\n"; <nil>
+}
+
+func ExampleSeq_EncodeTo() {
+	type point struct{ X, Y int }
+	err := loz.IterSlice([]point{{1, 2}, {3, 4}}).EncodeTo(json.NewEncoder(os.Stdout))
+	fmt.Print(err)
+	// Output: {"X":1,"Y":2}
+	// {"X":3,"Y":4}
+	// <nil>
+}
+
+func TestWriteErrors(t *testing.T) {
+	writeErr := errors.New("Testing error")
+	w := &failingWriter{limit: 5, err: writeErr}
+	n, err := loz.WriteLines(w, loz.Repeat("line").Take(10_000))
+	assert.Equal(t, writeErr, err)
+	assert.Equal(t, int64(5), n)
+
+	haltingErr := errors.New("halting error")
+	var sb strings.Builder
+	n, err = loz.WriteTo(&sb, loz.Generate(5, func(idx int) string {
+		if idx == 2 {
+			loz.PanicHaltIteration(haltingErr)
+		}
+		return fmt.Sprint(idx)
+	}), "-")
+	assert.Equal(t, haltingErr, err)
+	assert.Equal(t, int64(3), n)
+	assert.Equal(t, "0-1", sb.String())
+}
+
+func TestWriteToBufferedWriter(t *testing.T) {
+	writeErr := errors.New("Testing error")
+	bw := bufio.NewWriter(&failingWriter{limit: 0, err: writeErr})
+	_, _ = bw.WriteString(strings.Repeat("x", 34))
+	n, err := loz.WriteTo(bw, loz.IterSlice([]string{"ab"}), ",")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), n)
+	assert.Equal(t, 36, bw.Buffered())
+
+	bw = bufio.NewWriter(&failingWriter{limit: 0, err: writeErr})
+	_, _ = bw.WriteString(strings.Repeat("x", bw.Available()-6))
+	n, err = loz.WriteTo(bw, loz.IterSlice([]string{"abcd", "efgh"}), ",")
+	assert.Equal(t, writeErr, err)
+	// Only the 6 bytes that fit in the caller's buffer were accepted by it.
+	assert.Equal(t, int64(6), n)
+}
+
+func TestEncodeToError(t *testing.T) {
+	var sb strings.Builder
+	err := loz.IterSlice([]any{1, func() {}, 3}).EncodeTo(json.NewEncoder(&sb))
+	var unsupported *json.UnsupportedTypeError
+	assert.ErrorAs(t, err, &unsupported)
+	assert.Equal(t, "1\n", sb.String())
+}
@@ -0,0 +1,123 @@
+package loz
+
+import . "github.com/jmatth/loz/internal"
+
+// Optional holds a value that may be missing, such as one side of an outer
+// join. Valid is true if Value is present.
+type Optional[V any] struct {
+	Value V
+	Valid bool
+}
+
+func buildJoinTable[K comparable, V any](s KVSeq[K, V]) (table map[K][]V, keys []K) {
+	table = make(map[K][]V)
+	s(func(k K, v V) bool {
+		vals, ok := table[k]
+		if !ok {
+			keys = append(keys, k)
+		}
+		table[k] = append(vals, v)
+		return true
+	})
+	return table, keys
+}
+
+// InnerJoin pairs the values of left and right that share a key. When
+// iterated, right is consumed in full and built into a hash table, and then
+// left is streamed and each of its key/value pairs is yielded once for every
+// matching value from right, in the order they appeared in right. Keys that
+// appear on only one side are dropped.
+func InnerJoin[K comparable, A, B any](left KVSeq[K, A], right KVSeq[K, B]) KVSeq[K, Pair[A, B]] {
+	return func(yield Yielder2[K, Pair[A, B]]) {
+		table, _ := buildJoinTable(right)
+		left(func(k K, a A) bool {
+			for _, b := range table[k] {
+				if !yield(k, Pair[A, B]{a, b}) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+}
+
+// LeftJoin is identical to [InnerJoin], except key/value pairs from left that
+// have no match in right are still yielded once, with an invalid [Optional] in
+// place of the right value.
+func LeftJoin[K comparable, A, B any](left KVSeq[K, A], right KVSeq[K, B]) KVSeq[K, Pair[A, Optional[B]]] {
+	return func(yield Yielder2[K, Pair[A, Optional[B]]]) {
+		table, _ := buildJoinTable(right)
+		left(func(k K, a A) bool {
+			matches := table[k]
+			if len(matches) == 0 {
+				return yield(k, Pair[A, Optional[B]]{a, Optional[B]{}})
+			}
+			for _, b := range matches {
+				if !yield(k, Pair[A, Optional[B]]{a, Optional[B]{b, true}}) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+}
+
+// FullOuterJoin is identical to [LeftJoin], except once left is exhausted the
+// key/value pairs from right that never matched are yielded as well, with an
+// invalid [Optional] in place of the left value. Those unmatched pairs are
+// yielded in the order they appeared in right.
+func FullOuterJoin[K comparable, A, B any](left KVSeq[K, A], right KVSeq[K, B]) KVSeq[K, Pair[Optional[A], Optional[B]]] {
+	return func(yield Yielder2[K, Pair[Optional[A], Optional[B]]]) {
+		table, keys := buildJoinTable(right)
+		matched := make(map[K]struct{})
+		stopped := false
+		left(func(k K, a A) bool {
+			matches := table[k]
+			if len(matches) == 0 {
+				stopped = !yield(k, Pair[Optional[A], Optional[B]]{Optional[A]{a, true}, Optional[B]{}})
+				return !stopped
+			}
+			matched[k] = struct{}{}
+			for _, b := range matches {
+				if !yield(k, Pair[Optional[A], Optional[B]]{Optional[A]{a, true}, Optional[B]{b, true}}) {
+					stopped = true
+					return false
+				}
+			}
+			return true
+		})
+		if stopped {
+			return
+		}
+		for _, k := range keys {
+			if _, ok := matched[k]; ok {
+				continue
+			}
+			for _, b := range table[k] {
+				if !yield(k, Pair[Optional[A], Optional[B]]{Optional[A]{}, Optional[B]{b, true}}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// SemiJoin restricts left to the key/value pairs whose key appears in right.
+// Each pair from left is yielded at most once regardless of how many times its
+// key appears in right. When iterated, the keys of right are consumed in full
+// and held in memory before left is streamed.
+func SemiJoin[K comparable, A, B any](left KVSeq[K, A], right KVSeq[K, B]) KVSeq[K, A] {
+	return func(yield Yielder2[K, A]) {
+		keys := make(map[K]struct{})
+		right(func(k K, _ B) bool {
+			keys[k] = struct{}{}
+			return true
+		})
+		left(func(k K, a A) bool {
+			if _, ok := keys[k]; !ok {
+				return true
+			}
+			return yield(k, a)
+		})
+	}
+}
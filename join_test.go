@@ -0,0 +1,60 @@
+package loz_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleInnerJoin() {
+	users := iterKVPairs[int, string](1, "josh", 2, "katie", 3, "root")
+	logins := iterKVPairs[int, string](2, "monday", 1, "tuesday", 2, "friday")
+	loz.InnerJoin(users, logins).ForEach(func(id int, p loz.Pair[string, string]) {
+		fmt.Printf("%v %v: %v\n", id, p.First, p.Second)
+	})
+	// Output: 1 josh: tuesday
+	// 2 katie: monday
+	// 2 katie: friday
+}
+
+func ExampleLeftJoin() {
+	users := iterKVPairs[int, string](1, "josh", 3, "root")
+	emails := iterKVPairs[int, string](1, "josh@example.com")
+	loz.LeftJoin(users, emails).ForEach(func(id int, p loz.Pair[string, loz.Optional[string]]) {
+		fmt.Printf("%v %v: %v %v\n", id, p.First, p.Second.Value, p.Second.Valid)
+	})
+	// Output: 1 josh: josh@example.com true
+	// 3 root:  false
+}
+
+func ExampleFullOuterJoin() {
+	before := iterKVPairs[string, int]("a", 1, "b", 2)
+	after := iterKVPairs[string, int]("b", 20, "c", 30)
+	loz.FullOuterJoin(before, after).ForEach(func(k string, p loz.Pair[loz.Optional[int], loz.Optional[int]]) {
+		fmt.Printf("%v: %+v %+v\n", k, p.First, p.Second)
+	})
+	// Output: a: {Value:1 Valid:true} {Value:0 Valid:false}
+	// b: {Value:2 Valid:true} {Value:20 Valid:true}
+	// c: {Value:0 Valid:false} {Value:30 Valid:true}
+}
+
+func ExampleSemiJoin() {
+	users := iterKVPairs[int, string](1, "josh", 2, "katie", 3, "root")
+	active := iterKVPairs[int, bool](3, true, 1, true, 3, true)
+	loz.SemiJoin(users, active).ForEach(func(id int, name string) {
+		fmt.Printf("%v: %v\n", id, name)
+	})
+	// Output: 1: josh
+	// 3: root
+}
+
+func TestFullOuterJoinStopsEarly(t *testing.T) {
+	left := iterKVPairs[int, string](1, "a", 2, "b")
+	right := iterKVPairs[int, string](3, "c", 4, "d")
+	keys := loz.FullOuterJoin(left, right).Keys().Take(3).CollectSlice()
+	assert.Equal(t, []int{1, 2, 3}, keys)
+	keys = loz.FullOuterJoin(left, right).Keys().Take(1).CollectSlice()
+	assert.Equal(t, []int{1}, keys)
+}
@@ -0,0 +1,90 @@
+package loz
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	. "github.com/jmatth/loz/internal"
+)
+
+// DecodeJSONArray creates a Seq that decodes the elements of a JSON array read
+// from r one at a time, so the array never has to be held in memory all at
+// once. If the input is not an array or cannot be decoded into V, the
+// iteration is halted as if the error was passed to [PanicHaltIteration], so
+// the result should be consumed with a terminal method prefixed with "Try",
+// such as [Seq.TryCollectSlice], which will return the error.
+func DecodeJSONArray[V any](r io.Reader) Seq[V] {
+	return func(yield Yielder[V]) {
+		dec := json.NewDecoder(r)
+		tok, err := dec.Token()
+		PanicHaltIteration(err)
+		if tok != json.Delim('[') {
+			PanicHaltIteration(fmt.Errorf("expected start of JSON array, found %v", tok))
+		}
+		for dec.More() {
+			var v V
+			PanicHaltIteration(dec.Decode(&v))
+			if !yield(v) {
+				return
+			}
+		}
+		_, err = dec.Token()
+		PanicHaltIteration(err)
+	}
+}
+
+// DecodeNDJSON creates a Seq that decodes a stream of newline-delimited JSON
+// values read from r one at a time. Errors are reported the same as
+// [DecodeJSONArray].
+func DecodeNDJSON[V any](r io.Reader) Seq[V] {
+	return func(yield Yielder[V]) {
+		dec := json.NewDecoder(r)
+		for {
+			var v V
+			err := dec.Decode(&v)
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			PanicHaltIteration(err)
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// EncodeJSONArray writes the elements of s to w as a single JSON array
+// followed by a newline, encoding one element at a time. The output is
+// buffered and flushed once s is exhausted. The first encoding or write error
+// halts the iteration and is returned, as is any error passed to
+// [PanicHaltIteration] during the iteration.
+func EncodeJSONArray[V any](w io.Writer, s Seq[V]) error {
+	bw := bufio.NewWriter(w)
+	_, _ = bw.WriteString("[")
+	elements := func(yield Yielder[[]byte]) {
+		s(func(v V) bool {
+			encoded, err := json.Marshal(v)
+			PanicHaltIteration(err)
+			return yield(encoded)
+		})
+	}
+	if _, err := writeSeq(bw, elements, ",", ""); err != nil {
+		return err
+	}
+	_, _ = bw.WriteString("]\n")
+	return bw.Flush()
+}
+
+// EncodeNDJSON writes the elements of s to w as newline-delimited JSON values.
+// Buffering and errors are handled the same as [EncodeJSONArray].
+func EncodeNDJSON[V any](w io.Writer, s Seq[V]) error {
+	bw := bufio.NewWriter(w)
+	if err := s.EncodeTo(json.NewEncoder(bw)); err != nil {
+		_ = bw.Flush()
+		return err
+	}
+	return bw.Flush()
+}
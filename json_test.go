@@ -0,0 +1,97 @@
+package loz_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+type user struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func ExampleDecodeJSONArray() {
+	input := strings.NewReader(`[{"id": 1, "name": "josh"}, {"id": 1000, "name": "katie"}]`)
+	names, err := loz.DecodeJSONArray[user](input).
+		Filter(func(u user) bool { return u.ID >= 1000 }).
+		TryCollectSlice()
+	fmt.Printf("%+v; %v", names, err)
+	// Output: [{ID:1000 Name:katie}]; <nil>
+}
+
+func ExampleDecodeNDJSON() {
+	input := strings.NewReader("{\"id\": 1, \"name\": \"josh\"}\n{\"id\": 2, \"name\": \"katie\"}\n")
+	users, err := loz.DecodeNDJSON[user](input).TryCollectSlice()
+	fmt.Printf("%+v; %v", users, err)
+	// Output: [{ID:1 Name:josh} {ID:2 Name:katie}]; <nil>
+}
+
+func ExampleEncodeJSONArray() {
+	err := loz.EncodeJSONArray(os.Stdout, loz.IterSlice([]user{{1, "josh"}, {2, "katie"}}))
+	fmt.Print(err)
+	// Output: [{"id":1,"name":"josh"},{"id":2,"name":"katie"}]
+	// <nil>
+}
+
+func ExampleEncodeNDJSON() {
+	err := loz.EncodeNDJSON(os.Stdout, loz.IterSlice([]user{{1, "josh"}, {2, "katie"}}))
+	fmt.Print(err)
+	// Output: {"id":1,"name":"josh"}
+	// {"id":2,"name":"katie"}
+	// <nil>
+}
+
+func TestDecodeJSONArrayIsStreaming(t *testing.T) {
+	r, w := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		_, _ = io.WriteString(w, `[1, `)
+		<-done
+		_ = w.Close()
+	}()
+	first, err := loz.DecodeJSONArray[int](r).TryFirst()
+	close(done)
+	assert.Equal(t, 1, first)
+	assert.Nil(t, err)
+}
+
+func TestDecodeJSONErrors(t *testing.T) {
+	_, err := loz.DecodeJSONArray[int](strings.NewReader(`{"not": "an array"}`)).TryCollectSlice()
+	assert.ErrorContains(t, err, "expected start of JSON array")
+
+	nums, err := loz.DecodeJSONArray[int](strings.NewReader(`[1, "two", 3]`)).TryCollectSlice()
+	var typeErr *json.UnmarshalTypeError
+	assert.ErrorAs(t, err, &typeErr)
+	assert.Nil(t, nums)
+
+	_, err = loz.DecodeJSONArray[int](strings.NewReader(`[1, 2`)).TryCollectSlice()
+	assert.Error(t, err)
+
+	_, err = loz.DecodeNDJSON[int](strings.NewReader("1\n2\nthree\n")).TryCollectSlice()
+	var syntaxErr *json.SyntaxError
+	assert.ErrorAs(t, err, &syntaxErr)
+}
+
+func TestEncodeJSONArrayRoundTrip(t *testing.T) {
+	var sb strings.Builder
+	assert.Nil(t, loz.EncodeJSONArray(&sb, loz.IterSlice([]int{})))
+	assert.Equal(t, "[]\n", sb.String())
+
+	sb.Reset()
+	nums := loz.Generate(5, func(idx int) int { return idx })
+	assert.Nil(t, loz.EncodeJSONArray(&sb, nums))
+	decoded, err := loz.DecodeJSONArray[int](strings.NewReader(sb.String())).TryCollectSlice()
+	assert.Nil(t, err)
+	assert.Equal(t, nums.CollectSlice(), decoded)
+
+	err = loz.EncodeJSONArray(&sb, loz.IterSlice([]any{func() {}}))
+	var unsupported *json.UnsupportedTypeError
+	assert.ErrorAs(t, err, &unsupported)
+}
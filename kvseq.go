@@ -1,6 +1,7 @@
 package loz
 
 import (
+	"context"
 	"iter"
 	"maps"
 
@@ -13,11 +14,28 @@ import (
 // common use case and the relationship between the two values is arbitrary.
 type KVSeq[K, V any] iter.Seq2[K, V]
 
+// Pair holds two values of arbitrary types, such as a single key/value pair
+// from a [KVSeq].
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
 // IterMap creates a Seq over the key/value pairs of a map.
 func IterMap[K comparable, V any](input map[K]V) KVSeq[K, V] {
 	return KVSeq[K, V](maps.All(input))
 }
 
+func iterPairs[K, V any](pairs []Pair[K, V]) KVSeq[K, V] {
+	return func(yield Yielder2[K, V]) {
+		for _, p := range pairs {
+			if !yield(p.First, p.Second) {
+				return
+			}
+		}
+	}
+}
+
 // ToKeys converts a KVSeq[K, V] to a Seq[K], continuing the iteration with only
 // the keys.
 func (s KVSeq[K, V]) Keys() Seq[K] {
@@ -38,6 +56,28 @@ func (s KVSeq[K, V]) Values() Seq[V] {
 	}
 }
 
+// Unzip consumes the iterator and splits it into a Seq of its keys and a Seq of
+// its values. Both results are backed by slices, so they can be iterated any
+// number of times.
+func (s KVSeq[K, V]) Unzip() (Seq[K], Seq[V]) {
+	var keys []K
+	var vals []V
+	s(func(k K, v V) bool {
+		keys = append(keys, k)
+		vals = append(vals, v)
+		return true
+	})
+	return IterSlice(keys), IterSlice(vals)
+}
+
+// TryUnzip is identical to [KVSeq.Unzip], except it will recover any panic
+// caused by [PanicHaltIteration] and return the wrapped error.
+func (s KVSeq[K, V]) TryUnzip() (_ Seq[K], _ Seq[V], err error) {
+	defer RecoverHaltIteration(&err)
+	keys, vals := s.Unzip()
+	return keys, vals, nil
+}
+
 // ForEach consumes the iterator and calls the provided function with each of
 // the key/value pairs.
 func (s KVSeq[K, V]) ForEach(process func(K, V)) {
@@ -83,6 +123,20 @@ func (s KVSeq[K, V]) FilterMap(mapper func(K, V) (K, V, bool)) KVSeq[K, V] {
 	}
 }
 
+// MapErr is identical to [KVSeq.Map], except the mapper may return an error.
+// The first non-nil error halts the iteration as if passed to
+// [PanicHaltIteration], so it should be consumed with a terminal method
+// prefixed with "Try", such as [KVSeq.TryForEach], which will return the error.
+func (s KVSeq[K, V]) MapErr(mapper FilteringMapperErr2[K, V, K, V]) KVSeq[K, V] {
+	return func(yield Yielder2[K, V]) {
+		s(func(k K, v V) bool {
+			mk, mv, err := mapper(k, v)
+			PanicHaltIteration(err)
+			return yield(mk, mv)
+		})
+	}
+}
+
 // Reduce reduces the iterator to a single key/value pair by iteratively
 // combining its elements using the provided function. If the iterator is empty
 // then zero values will be returned along with an error.
@@ -255,6 +309,46 @@ func (s KVSeq[K, V]) Filter(filter Yielder2[K, V]) KVSeq[K, V] {
 	}
 }
 
+// WithContext restricts the iterator to the key/value pairs yielded before ctx
+// is done. Once ctx is done the iteration is halted as if ctx.Err() was passed
+// to [PanicHaltIteration], so the result should be consumed with a terminal
+// method prefixed with "Try", such as [KVSeq.TryForEach], which will return
+// the context's error.
+func (s KVSeq[K, V]) WithContext(ctx context.Context) KVSeq[K, V] {
+	return func(yield Yielder2[K, V]) {
+		PanicHaltIteration(ctx.Err())
+		s(func(k K, v V) bool {
+			PanicHaltIteration(ctx.Err())
+			return yield(k, v)
+		})
+	}
+}
+
+// Partition consumes the iterator and splits its key/value pairs into those
+// for which test returns true and those for which it returns false, calling
+// test exactly once per pair. Both results are backed by slices, so they can be
+// iterated any number of times.
+func (s KVSeq[K, V]) Partition(test Yielder2[K, V]) (matched, rest KVSeq[K, V]) {
+	var matchedPairs, restPairs []Pair[K, V]
+	s(func(k K, v V) bool {
+		if test(k, v) {
+			matchedPairs = append(matchedPairs, Pair[K, V]{k, v})
+		} else {
+			restPairs = append(restPairs, Pair[K, V]{k, v})
+		}
+		return true
+	})
+	return iterPairs(matchedPairs), iterPairs(restPairs)
+}
+
+// TryPartition is identical to [KVSeq.Partition], except it will recover any
+// panic caused by [PanicHaltIteration] and return the wrapped error.
+func (s KVSeq[K, V]) TryPartition(test Yielder2[K, V]) (matched, rest KVSeq[K, V], err error) {
+	defer RecoverHaltIteration(&err)
+	matched, rest = s.Partition(test)
+	return matched, rest, nil
+}
+
 // Skip skips the first toSkip key/value pairs of the iterator. If toSkip is
 // greater than or equal to the number of elements in the iterator the result
 // will be an empty iterator.
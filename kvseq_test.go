@@ -1,15 +1,18 @@
 package loz_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"iter"
 	"maps"
 	"slices"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/jmatth/loz"
+	lom "github.com/jmatth/loz/mapping"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,12 +48,48 @@ func iterKVPairs[K, V any](kvs ...any) loz.KVSeq[K, V] {
 	}
 }
 
-// func ExampleCompKKVSeq_CollectMap() {
-// 	result := CompKKVSeq[int, string](iterKVPairs[int, string](1, "one", 2, "two", 3, "three")).
-// 		CollectMap()
-// 	fmt.Printf("%v", result)
-// 	// Output: map[1:one 2:two 3:three]
-// }
+func ExampleCompKKVSeq_CollectMap() {
+	result := loz.CompKKVSeq[int, string](iterKVPairs[int, string](1, "one", 2, "two", 3, "three")).
+		CollectMap()
+	fmt.Printf("%v", result)
+	// Output: map[1:one 2:two 3:three]
+}
+
+func ExampleCompKKVSeq_CollectMapFunc() {
+	words := loz.IterSlice([]string{"apple", "avocado", "banana", "blueberry", "cherry"})
+	byLetter := loz.CompKKVSeq[byte, string](lom.KVMap1[int, string, byte, string](words.Indexed()).
+		Map(func(_ int, w string) (byte, string) { return w[0], w })).
+		CollectMapFunc(func(existing, incoming string) string {
+			return existing + "," + incoming
+		})
+	fmt.Printf("%q", byLetter)
+	// Output: map['a':"apple,avocado" 'b':"banana,blueberry" 'c':"cherry"]
+}
+
+func TestCompKKVSeqTryCollectMap(t *testing.T) {
+	haltingErr := errors.New("Testing error")
+	seq := loz.CompKKVSeq[int, string](iterKVPairs[int, string](1, "one", 2, "two", 1, "uno"))
+	result, err := seq.TryCollectMap()
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]string{1: "uno", 2: "two"}, result)
+
+	result, err = seq.TryCollectMapFunc(func(existing, _ string) string { return existing })
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]string{1: "one", 2: "two"}, result)
+
+	halting := loz.CompKKVSeq[int, string](loz.KVSeq[int, string](seq).Map(func(k int, v string) (int, string) {
+		if k == 2 {
+			loz.PanicHaltIteration(haltingErr)
+		}
+		return k, v
+	}))
+	result, err = halting.TryCollectMap()
+	assert.Nil(t, result)
+	assert.Equal(t, haltingErr, err)
+	result, err = halting.TryCollectMapFunc(func(existing, _ string) string { return existing })
+	assert.Nil(t, result)
+	assert.Equal(t, haltingErr, err)
+}
 
 func ExampleKVSeq_ForEach() {
 	iterKVPairs[int, string](1, "one", 2, "two", 3, "three").
@@ -123,6 +162,14 @@ func ExampleKVSeq_Filter() {
 	// Output: map[1:one 2:two 3:three]
 }
 
+func ExampleKVSeq_Partition() {
+	short, long := loz.IterSlice([]string{"zero", "one", "two", "three"}).
+		Indexed().
+		Partition(func(_ int, v string) bool { return len(v) <= 3 })
+	fmt.Printf("%v %v", toMap(short), toMap(long))
+	// Output: map[1:one 2:two] map[0:zero 3:three]
+}
+
 func ExampleKVSeq_Any() {
 	seq := loz.IterMap(map[string]string{
 		"greeting": "Hello there!",
@@ -230,6 +277,39 @@ func ExampleKVSeq_FilterMap() {
 	// Output: [1 3]
 }
 
+func ExampleKVSeq_MapErr() {
+	var visited []int
+	err := loz.IterSlice([]string{"1", "2", "three", "4"}).
+		Indexed().
+		MapErr(func(i int, s string) (int, string, error) {
+			_, err := strconv.Atoi(s)
+			return i, s, err
+		}).
+		TryForEach(func(i int, _ string) {
+			visited = append(visited, i)
+		})
+	fmt.Printf("%v; %v", visited, err)
+	// Output: [0 1]; strconv.Atoi: parsing "three": invalid syntax
+}
+
+func ExampleKVSeq_WithContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err := loz.IterSlice([]string{"zero", "one", "two", "three"}).
+		Indexed().
+		WithContext(ctx).
+		TryForEach(func(i int, s string) {
+			fmt.Printf("%v: %v\n", i, s)
+			if i == 1 {
+				cancel()
+			}
+		})
+	fmt.Print(err)
+	// Output: 0: zero
+	// 1: one
+	// context canceled
+}
+
 func TestKVSeqTryMethods(t *testing.T) {
 	seq := loz.IterMap(map[int]string{1: "one", 2: "two", 3: "three"})
 	haltingErr := errors.New("Testing error")
@@ -297,6 +377,15 @@ func TestKVSeqTryMethods(t *testing.T) {
 				return err
 			},
 		},
+		{
+			"TryPartition",
+			func(s loz.KVSeq[int, string]) error {
+				_, _, err := s.TryPartition(func(i int, s string) bool {
+					return i%2 == 0
+				})
+				return err
+			},
+		},
 		{
 			"TryEvery",
 			func(s loz.KVSeq[int, string]) error {
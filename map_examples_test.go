@@ -22,6 +22,17 @@ func Example_foldWithMap() {
 	// Output: 1, 2, 3, 4, 5
 }
 
+func Example_scanWithMap() {
+	balances := lom.Map1[string, float64](loz.IterSlice([]string{"+10", "-2.5", "+4"})).
+		Scan(100, func(balance float64, txn string) float64 {
+			amount, _ := strconv.ParseFloat(txn, 64)
+			return balance + amount
+		}).
+		CollectSlice()
+	fmt.Printf("%v", balances)
+	// Output: [110 107.5 111.5]
+}
+
 func Example_haltOnErrorWithMap() {
 	nums, err := lom.Map1[string, int](loz.IterSlice([]string{"1", "two", "3"})).
 		Map(func(str string) int {
@@ -34,6 +45,14 @@ func Example_haltOnErrorWithMap() {
 	// Output: []; strconv.Atoi: parsing "two": invalid syntax
 }
 
+func Example_haltOnErrorWithMapErr() {
+	nums, err := lom.Map1[string, int](loz.IterSlice([]string{"1", "two", "3"})).
+		MapErr(strconv.Atoi).
+		TryCollectSlice()
+	fmt.Printf("%v; %v\n", nums, err)
+	// Output: []; strconv.Atoi: parsing "two": invalid syntax
+}
+
 func Example_skipOnErrorWithMap() {
 	nums := lom.Map1[string, int](loz.IterSlice([]string{"1", "two", "3"})).
 		FilterMap(func(num string) (int, bool) {
@@ -1,6 +1,8 @@
 package mapping
 
 import (
+	"context"
+
 	. "github.com/jmatth/loz"
 	. "github.com/jmatth/loz/internal"
 )
@@ -16,6 +18,24 @@ func (s Map1[V1, V2]) Map(Mapper Mapper[V1, V2]) Seq[V2] {
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s Map1[V1, V2]) MapErr(Mapper FilteringMapperErr[V1, V2]) Seq[V2] {
+	return func(yield Yielder[V2]) {
+		s(func(v V1) bool {
+			mapped, err := Mapper(v)
+			PanicHaltIteration(err)
+			return yield(mapped)
+		})
+	}
+}
+
+// ParallelMap is identical to Map, except the Mapper is called concurrently on
+// a pool of workers goroutines. See [loz.Seq.ParallelMap].
+func (s Map1[V1, V2]) ParallelMap(workers int, Mapper Mapper[V1, V2]) Seq[V2] {
+	return ParallelMap(s, workers, Mapper)
+}
+
 func (s Map1[V1, V2]) FilterMap(Mapper FilteringMapper[V1, V2]) Seq[V2] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -28,6 +48,38 @@ func (s Map1[V1, V2]) FilterMap(Mapper FilteringMapper[V1, V2]) Seq[V2] {
 	}
 }
 
+// MapChunks transforms consecutive batches of elements using the provided
+// Mapper function. See [loz.Chunk].
+func (s Map1[V1, V2]) MapChunks(n int, Mapper Mapper[[]V1, V2]) Seq[V2] {
+	return func(yield Yielder[V2]) {
+		Chunk(Seq[V1](s), n)(func(chunk []V1) bool {
+			return yield(Mapper(chunk))
+		})
+	}
+}
+
+// MapWindows transforms sliding windows of elements using the provided Mapper
+// function. See [loz.Window].
+func (s Map1[V1, V2]) MapWindows(size, step int, Mapper Mapper[[]V1, V2]) Seq[V2] {
+	return func(yield Yielder[V2]) {
+		Window(Seq[V1](s), size, step)(func(window []V1) bool {
+			return yield(Mapper(window))
+		})
+	}
+}
+
+// Scan is identical to [loz.Seq.Scan] except that the type of the accumulator
+// can be different than the type of the elements in the sequence.
+func (s Map1[V1, V2]) Scan(initial V2, combine Reducer[V1, V2]) Seq[V2] {
+	return func(yield Yielder[V2]) {
+		acc := initial
+		s(func(v V1) bool {
+			acc = combine(acc, v)
+			return yield(acc)
+		})
+	}
+}
+
 func (s Map1[V1, V2]) Expand(toElements Mapper[V1, Seq[V2]]) Seq[V2] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -41,6 +93,21 @@ func (s Map1[V1, V2]) Expand(toElements Mapper[V1, Seq[V2]]) Seq[V2] {
 	}
 }
 
+// See [loz.Seq.Chain].
+func (s Map1[V1, V2]) Chain(others ...Seq[V1]) Map1[V1, V2] {
+	return Map1[V1, V2](Seq[V1](s).Chain(others...))
+}
+
+// See [loz.Seq.Cycle].
+func (s Map1[V1, V2]) Cycle() Map1[V1, V2] {
+	return Map1[V1, V2](Seq[V1](s).Cycle())
+}
+
+// See [loz.Seq.DedupFunc].
+func (s Map1[V1, V2]) DedupFunc(equal func(a, b V1) bool) Map1[V1, V2] {
+	return Map1[V1, V2](Seq[V1](s).DedupFunc(equal))
+}
+
 // See [loz.Seq.Filter].
 func (s Map1[V1, V2]) Filter(filter Yielder[V1]) Map1[V1, V2] {
 	return Map1[V1, V2](Seq[V1](s).Filter(filter))
@@ -56,6 +123,11 @@ func (s Map1[V1, V2]) SkipWhile(test Yielder[V1]) Map1[V1, V2] {
 	return Map1[V1, V2](Seq[V1](s).SkipWhile(test))
 }
 
+// See [loz.Seq.SortedFunc].
+func (s Map1[V1, V2]) SortedFunc(compare func(a, b V1) int) Map1[V1, V2] {
+	return Map1[V1, V2](Seq[V1](s).SortedFunc(compare))
+}
+
 // See [loz.Seq.Take].
 func (s Map1[V1, V2]) Take(toTake int) Map1[V1, V2] {
 	return Map1[V1, V2](Seq[V1](s).Take(toTake))
@@ -66,6 +138,21 @@ func (s Map1[V1, V2]) TakeWhile(test Yielder[V1]) Map1[V1, V2] {
 	return Map1[V1, V2](Seq[V1](s).TakeWhile(test))
 }
 
+// See [loz.Seq.ParallelFilter].
+func (s Map1[V1, V2]) ParallelFilter(workers int, filter Yielder[V1]) Map1[V1, V2] {
+	return Map1[V1, V2](Seq[V1](s).ParallelFilter(workers, filter))
+}
+
+// See [loz.Seq.TopK].
+func (s Map1[V1, V2]) TopK(n int, compare func(a, b V1) int) Map1[V1, V2] {
+	return Map1[V1, V2](Seq[V1](s).TopK(n, compare))
+}
+
+// See [loz.Seq.WithContext].
+func (s Map1[V1, V2]) WithContext(ctx context.Context) Map1[V1, V2] {
+	return Map1[V1, V2](Seq[V1](s).WithContext(ctx))
+}
+
 type KVMap1[K1, V1, K2, V2 any] KVSeq[K1, V1]
 
 // Map transforms the keys and values within the iterator using the provided Mapper function.
@@ -77,6 +164,18 @@ func (s KVMap1[K1, V1, K2, V2]) Map(Mapper Mapper2[K1, V1, K2, V2]) KVSeq[K2, V2
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s KVMap1[K1, V1, K2, V2]) MapErr(Mapper FilteringMapperErr2[K1, V1, K2, V2]) KVSeq[K2, V2] {
+	return func(yield Yielder2[K2, V2]) {
+		s(func(k K1, v V1) bool {
+			mk, mv, err := Mapper(k, v)
+			PanicHaltIteration(err)
+			return yield(mk, mv)
+		})
+	}
+}
+
 // Map transforms the keys and values within the iterator using the provided Mapper function.
 func (s KVMap1[K1, V1, K2, V2]) FilterMap(Mapper FilteringMapper2[K1, V1, K2, V2]) KVSeq[K2, V2] {
 	return func(yield Yielder2[K2, V2]) {
@@ -90,6 +189,11 @@ func (s KVMap1[K1, V1, K2, V2]) FilterMap(Mapper FilteringMapper2[K1, V1, K2, V2
 	}
 }
 
+// See [KVSeq.Chain].
+func (s KVMap1[K1, V1, K2, V2]) Chain(others ...KVSeq[K1, V1]) KVMap1[K1, V1, K2, V2] {
+	return KVMap1[K1, V1, K2, V2](KVSeq[K1, V1](s).Chain(others...))
+}
+
 // See [KVSeq.Filter].
 func (s KVMap1[K1, V1, K2, V2]) Filter(filter Yielder2[K1, V1]) KVMap1[K1, V1, K2, V2] {
 	return KVMap1[K1, V1, K2, V2](KVSeq[K1, V1](s).Filter(filter))
@@ -105,6 +209,16 @@ func (s KVMap1[K1, V1, K2, V2]) SkipWhile(test Yielder2[K1, V1]) KVMap1[K1, V1,
 	return KVMap1[K1, V1, K2, V2](KVSeq[K1, V1](s).SkipWhile(test))
 }
 
+// See [KVSeq.SortedByKey].
+func (s KVMap1[K1, V1, K2, V2]) SortedByKey(compare func(a, b K1) int) KVMap1[K1, V1, K2, V2] {
+	return KVMap1[K1, V1, K2, V2](KVSeq[K1, V1](s).SortedByKey(compare))
+}
+
+// See [KVSeq.SortedByValue].
+func (s KVMap1[K1, V1, K2, V2]) SortedByValue(compare func(a, b V1) int) KVMap1[K1, V1, K2, V2] {
+	return KVMap1[K1, V1, K2, V2](KVSeq[K1, V1](s).SortedByValue(compare))
+}
+
 // See [KVSeq.Take].
 func (s KVMap1[K1, V1, K2, V2]) Take(toTake int) KVMap1[K1, V1, K2, V2] {
 	return KVMap1[K1, V1, K2, V2](KVSeq[K1, V1](s).Take(toTake))
@@ -115,6 +229,11 @@ func (s KVMap1[K1, V1, K2, V2]) TakeWhile(test Yielder2[K1, V1]) KVMap1[K1, V1,
 	return KVMap1[K1, V1, K2, V2](KVSeq[K1, V1](s).TakeWhile(test))
 }
 
+// See [KVSeq.WithContext].
+func (s KVMap1[K1, V1, K2, V2]) WithContext(ctx context.Context) KVMap1[K1, V1, K2, V2] {
+	return KVMap1[K1, V1, K2, V2](KVSeq[K1, V1](s).WithContext(ctx))
+}
+
 type Map2[V1, V2, V3 any] Map1[V1, V2]
 
 // Map transforms the elements within the iterator using the provided Mapper function.
@@ -126,6 +245,24 @@ func (s Map2[V1, V2, V3]) Map(Mapper Mapper[V1, V2]) Map1[V2, V3] {
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s Map2[V1, V2, V3]) MapErr(Mapper FilteringMapperErr[V1, V2]) Map1[V2, V3] {
+	return func(yield Yielder[V2]) {
+		s(func(v V1) bool {
+			mapped, err := Mapper(v)
+			PanicHaltIteration(err)
+			return yield(mapped)
+		})
+	}
+}
+
+// ParallelMap is identical to Map, except the Mapper is called concurrently on
+// a pool of workers goroutines. See [loz.Seq.ParallelMap].
+func (s Map2[V1, V2, V3]) ParallelMap(workers int, Mapper Mapper[V1, V2]) Map1[V2, V3] {
+	return ParallelMap(s, workers, Mapper)
+}
+
 func (s Map2[V1, V2, V3]) FilterMap(Mapper FilteringMapper[V1, V2]) Map1[V2, V3] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -138,6 +275,38 @@ func (s Map2[V1, V2, V3]) FilterMap(Mapper FilteringMapper[V1, V2]) Map1[V2, V3]
 	}
 }
 
+// MapChunks transforms consecutive batches of elements using the provided
+// Mapper function. See [loz.Chunk].
+func (s Map2[V1, V2, V3]) MapChunks(n int, Mapper Mapper[[]V1, V2]) Map1[V2, V3] {
+	return func(yield Yielder[V2]) {
+		Chunk(Seq[V1](s), n)(func(chunk []V1) bool {
+			return yield(Mapper(chunk))
+		})
+	}
+}
+
+// MapWindows transforms sliding windows of elements using the provided Mapper
+// function. See [loz.Window].
+func (s Map2[V1, V2, V3]) MapWindows(size, step int, Mapper Mapper[[]V1, V2]) Map1[V2, V3] {
+	return func(yield Yielder[V2]) {
+		Window(Seq[V1](s), size, step)(func(window []V1) bool {
+			return yield(Mapper(window))
+		})
+	}
+}
+
+// Scan is identical to [loz.Seq.Scan] except that the type of the accumulator
+// can be different than the type of the elements in the sequence.
+func (s Map2[V1, V2, V3]) Scan(initial V2, combine Reducer[V1, V2]) Map1[V2, V3] {
+	return func(yield Yielder[V2]) {
+		acc := initial
+		s(func(v V1) bool {
+			acc = combine(acc, v)
+			return yield(acc)
+		})
+	}
+}
+
 func (s Map2[V1, V2, V3]) Expand(toElements Mapper[V1, Seq[V2]]) Map1[V2, V3] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -151,6 +320,21 @@ func (s Map2[V1, V2, V3]) Expand(toElements Mapper[V1, Seq[V2]]) Map1[V2, V3] {
 	}
 }
 
+// See [loz.Seq.Chain].
+func (s Map2[V1, V2, V3]) Chain(others ...Seq[V1]) Map2[V1, V2, V3] {
+	return Map2[V1, V2, V3](Seq[V1](s).Chain(others...))
+}
+
+// See [loz.Seq.Cycle].
+func (s Map2[V1, V2, V3]) Cycle() Map2[V1, V2, V3] {
+	return Map2[V1, V2, V3](Seq[V1](s).Cycle())
+}
+
+// See [loz.Seq.DedupFunc].
+func (s Map2[V1, V2, V3]) DedupFunc(equal func(a, b V1) bool) Map2[V1, V2, V3] {
+	return Map2[V1, V2, V3](Seq[V1](s).DedupFunc(equal))
+}
+
 // See [loz.Seq.Filter].
 func (s Map2[V1, V2, V3]) Filter(filter Yielder[V1]) Map2[V1, V2, V3] {
 	return Map2[V1, V2, V3](Seq[V1](s).Filter(filter))
@@ -166,6 +350,11 @@ func (s Map2[V1, V2, V3]) SkipWhile(test Yielder[V1]) Map2[V1, V2, V3] {
 	return Map2[V1, V2, V3](Seq[V1](s).SkipWhile(test))
 }
 
+// See [loz.Seq.SortedFunc].
+func (s Map2[V1, V2, V3]) SortedFunc(compare func(a, b V1) int) Map2[V1, V2, V3] {
+	return Map2[V1, V2, V3](Seq[V1](s).SortedFunc(compare))
+}
+
 // See [loz.Seq.Take].
 func (s Map2[V1, V2, V3]) Take(toTake int) Map2[V1, V2, V3] {
 	return Map2[V1, V2, V3](Seq[V1](s).Take(toTake))
@@ -176,6 +365,21 @@ func (s Map2[V1, V2, V3]) TakeWhile(test Yielder[V1]) Map2[V1, V2, V3] {
 	return Map2[V1, V2, V3](Seq[V1](s).TakeWhile(test))
 }
 
+// See [loz.Seq.ParallelFilter].
+func (s Map2[V1, V2, V3]) ParallelFilter(workers int, filter Yielder[V1]) Map2[V1, V2, V3] {
+	return Map2[V1, V2, V3](Seq[V1](s).ParallelFilter(workers, filter))
+}
+
+// See [loz.Seq.TopK].
+func (s Map2[V1, V2, V3]) TopK(n int, compare func(a, b V1) int) Map2[V1, V2, V3] {
+	return Map2[V1, V2, V3](Seq[V1](s).TopK(n, compare))
+}
+
+// See [loz.Seq.WithContext].
+func (s Map2[V1, V2, V3]) WithContext(ctx context.Context) Map2[V1, V2, V3] {
+	return Map2[V1, V2, V3](Seq[V1](s).WithContext(ctx))
+}
+
 type KVMap2[K1, V1, K2, V2, K3, V3 any] KVMap1[K1, V1, K2, V2]
 
 // Map transforms the keys and values within the iterator using the provided Mapper function.
@@ -187,6 +391,18 @@ func (s KVMap2[K1, V1, K2, V2, K3, V3]) Map(Mapper Mapper2[K1, V1, K2, V2]) KVMa
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s KVMap2[K1, V1, K2, V2, K3, V3]) MapErr(Mapper FilteringMapperErr2[K1, V1, K2, V2]) KVMap1[K2, V2, K3, V3] {
+	return func(yield Yielder2[K2, V2]) {
+		s(func(k K1, v V1) bool {
+			mk, mv, err := Mapper(k, v)
+			PanicHaltIteration(err)
+			return yield(mk, mv)
+		})
+	}
+}
+
 // Map transforms the keys and values within the iterator using the provided Mapper function.
 func (s KVMap2[K1, V1, K2, V2, K3, V3]) FilterMap(Mapper FilteringMapper2[K1, V1, K2, V2]) KVMap1[K2, V2, K3, V3] {
 	return func(yield Yielder2[K2, V2]) {
@@ -200,6 +416,11 @@ func (s KVMap2[K1, V1, K2, V2, K3, V3]) FilterMap(Mapper FilteringMapper2[K1, V1
 	}
 }
 
+// See [KVSeq.Chain].
+func (s KVMap2[K1, V1, K2, V2, K3, V3]) Chain(others ...KVSeq[K1, V1]) KVMap2[K1, V1, K2, V2, K3, V3] {
+	return KVMap2[K1, V1, K2, V2, K3, V3](KVSeq[K1, V1](s).Chain(others...))
+}
+
 // See [KVSeq.Filter].
 func (s KVMap2[K1, V1, K2, V2, K3, V3]) Filter(filter Yielder2[K1, V1]) KVMap2[K1, V1, K2, V2, K3, V3] {
 	return KVMap2[K1, V1, K2, V2, K3, V3](KVSeq[K1, V1](s).Filter(filter))
@@ -215,6 +436,16 @@ func (s KVMap2[K1, V1, K2, V2, K3, V3]) SkipWhile(test Yielder2[K1, V1]) KVMap2[
 	return KVMap2[K1, V1, K2, V2, K3, V3](KVSeq[K1, V1](s).SkipWhile(test))
 }
 
+// See [KVSeq.SortedByKey].
+func (s KVMap2[K1, V1, K2, V2, K3, V3]) SortedByKey(compare func(a, b K1) int) KVMap2[K1, V1, K2, V2, K3, V3] {
+	return KVMap2[K1, V1, K2, V2, K3, V3](KVSeq[K1, V1](s).SortedByKey(compare))
+}
+
+// See [KVSeq.SortedByValue].
+func (s KVMap2[K1, V1, K2, V2, K3, V3]) SortedByValue(compare func(a, b V1) int) KVMap2[K1, V1, K2, V2, K3, V3] {
+	return KVMap2[K1, V1, K2, V2, K3, V3](KVSeq[K1, V1](s).SortedByValue(compare))
+}
+
 // See [KVSeq.Take].
 func (s KVMap2[K1, V1, K2, V2, K3, V3]) Take(toTake int) KVMap2[K1, V1, K2, V2, K3, V3] {
 	return KVMap2[K1, V1, K2, V2, K3, V3](KVSeq[K1, V1](s).Take(toTake))
@@ -225,6 +456,11 @@ func (s KVMap2[K1, V1, K2, V2, K3, V3]) TakeWhile(test Yielder2[K1, V1]) KVMap2[
 	return KVMap2[K1, V1, K2, V2, K3, V3](KVSeq[K1, V1](s).TakeWhile(test))
 }
 
+// See [KVSeq.WithContext].
+func (s KVMap2[K1, V1, K2, V2, K3, V3]) WithContext(ctx context.Context) KVMap2[K1, V1, K2, V2, K3, V3] {
+	return KVMap2[K1, V1, K2, V2, K3, V3](KVSeq[K1, V1](s).WithContext(ctx))
+}
+
 type Map3[V1, V2, V3, V4 any] Map2[V1, V2, V3]
 
 // Map transforms the elements within the iterator using the provided Mapper function.
@@ -236,6 +472,24 @@ func (s Map3[V1, V2, V3, V4]) Map(Mapper Mapper[V1, V2]) Map2[V2, V3, V4] {
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s Map3[V1, V2, V3, V4]) MapErr(Mapper FilteringMapperErr[V1, V2]) Map2[V2, V3, V4] {
+	return func(yield Yielder[V2]) {
+		s(func(v V1) bool {
+			mapped, err := Mapper(v)
+			PanicHaltIteration(err)
+			return yield(mapped)
+		})
+	}
+}
+
+// ParallelMap is identical to Map, except the Mapper is called concurrently on
+// a pool of workers goroutines. See [loz.Seq.ParallelMap].
+func (s Map3[V1, V2, V3, V4]) ParallelMap(workers int, Mapper Mapper[V1, V2]) Map2[V2, V3, V4] {
+	return ParallelMap(s, workers, Mapper)
+}
+
 func (s Map3[V1, V2, V3, V4]) FilterMap(Mapper FilteringMapper[V1, V2]) Map2[V2, V3, V4] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -248,6 +502,38 @@ func (s Map3[V1, V2, V3, V4]) FilterMap(Mapper FilteringMapper[V1, V2]) Map2[V2,
 	}
 }
 
+// MapChunks transforms consecutive batches of elements using the provided
+// Mapper function. See [loz.Chunk].
+func (s Map3[V1, V2, V3, V4]) MapChunks(n int, Mapper Mapper[[]V1, V2]) Map2[V2, V3, V4] {
+	return func(yield Yielder[V2]) {
+		Chunk(Seq[V1](s), n)(func(chunk []V1) bool {
+			return yield(Mapper(chunk))
+		})
+	}
+}
+
+// MapWindows transforms sliding windows of elements using the provided Mapper
+// function. See [loz.Window].
+func (s Map3[V1, V2, V3, V4]) MapWindows(size, step int, Mapper Mapper[[]V1, V2]) Map2[V2, V3, V4] {
+	return func(yield Yielder[V2]) {
+		Window(Seq[V1](s), size, step)(func(window []V1) bool {
+			return yield(Mapper(window))
+		})
+	}
+}
+
+// Scan is identical to [loz.Seq.Scan] except that the type of the accumulator
+// can be different than the type of the elements in the sequence.
+func (s Map3[V1, V2, V3, V4]) Scan(initial V2, combine Reducer[V1, V2]) Map2[V2, V3, V4] {
+	return func(yield Yielder[V2]) {
+		acc := initial
+		s(func(v V1) bool {
+			acc = combine(acc, v)
+			return yield(acc)
+		})
+	}
+}
+
 func (s Map3[V1, V2, V3, V4]) Expand(toElements Mapper[V1, Seq[V2]]) Map2[V2, V3, V4] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -261,6 +547,21 @@ func (s Map3[V1, V2, V3, V4]) Expand(toElements Mapper[V1, Seq[V2]]) Map2[V2, V3
 	}
 }
 
+// See [loz.Seq.Chain].
+func (s Map3[V1, V2, V3, V4]) Chain(others ...Seq[V1]) Map3[V1, V2, V3, V4] {
+	return Map3[V1, V2, V3, V4](Seq[V1](s).Chain(others...))
+}
+
+// See [loz.Seq.Cycle].
+func (s Map3[V1, V2, V3, V4]) Cycle() Map3[V1, V2, V3, V4] {
+	return Map3[V1, V2, V3, V4](Seq[V1](s).Cycle())
+}
+
+// See [loz.Seq.DedupFunc].
+func (s Map3[V1, V2, V3, V4]) DedupFunc(equal func(a, b V1) bool) Map3[V1, V2, V3, V4] {
+	return Map3[V1, V2, V3, V4](Seq[V1](s).DedupFunc(equal))
+}
+
 // See [loz.Seq.Filter].
 func (s Map3[V1, V2, V3, V4]) Filter(filter Yielder[V1]) Map3[V1, V2, V3, V4] {
 	return Map3[V1, V2, V3, V4](Seq[V1](s).Filter(filter))
@@ -276,6 +577,11 @@ func (s Map3[V1, V2, V3, V4]) SkipWhile(test Yielder[V1]) Map3[V1, V2, V3, V4] {
 	return Map3[V1, V2, V3, V4](Seq[V1](s).SkipWhile(test))
 }
 
+// See [loz.Seq.SortedFunc].
+func (s Map3[V1, V2, V3, V4]) SortedFunc(compare func(a, b V1) int) Map3[V1, V2, V3, V4] {
+	return Map3[V1, V2, V3, V4](Seq[V1](s).SortedFunc(compare))
+}
+
 // See [loz.Seq.Take].
 func (s Map3[V1, V2, V3, V4]) Take(toTake int) Map3[V1, V2, V3, V4] {
 	return Map3[V1, V2, V3, V4](Seq[V1](s).Take(toTake))
@@ -286,6 +592,21 @@ func (s Map3[V1, V2, V3, V4]) TakeWhile(test Yielder[V1]) Map3[V1, V2, V3, V4] {
 	return Map3[V1, V2, V3, V4](Seq[V1](s).TakeWhile(test))
 }
 
+// See [loz.Seq.ParallelFilter].
+func (s Map3[V1, V2, V3, V4]) ParallelFilter(workers int, filter Yielder[V1]) Map3[V1, V2, V3, V4] {
+	return Map3[V1, V2, V3, V4](Seq[V1](s).ParallelFilter(workers, filter))
+}
+
+// See [loz.Seq.TopK].
+func (s Map3[V1, V2, V3, V4]) TopK(n int, compare func(a, b V1) int) Map3[V1, V2, V3, V4] {
+	return Map3[V1, V2, V3, V4](Seq[V1](s).TopK(n, compare))
+}
+
+// See [loz.Seq.WithContext].
+func (s Map3[V1, V2, V3, V4]) WithContext(ctx context.Context) Map3[V1, V2, V3, V4] {
+	return Map3[V1, V2, V3, V4](Seq[V1](s).WithContext(ctx))
+}
+
 type KVMap3[K1, V1, K2, V2, K3, V3, K4, V4 any] KVMap2[K1, V1, K2, V2, K3, V3]
 
 // Map transforms the keys and values within the iterator using the provided Mapper function.
@@ -297,6 +618,18 @@ func (s KVMap3[K1, V1, K2, V2, K3, V3, K4, V4]) Map(Mapper Mapper2[K1, V1, K2, V
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s KVMap3[K1, V1, K2, V2, K3, V3, K4, V4]) MapErr(Mapper FilteringMapperErr2[K1, V1, K2, V2]) KVMap2[K2, V2, K3, V3, K4, V4] {
+	return func(yield Yielder2[K2, V2]) {
+		s(func(k K1, v V1) bool {
+			mk, mv, err := Mapper(k, v)
+			PanicHaltIteration(err)
+			return yield(mk, mv)
+		})
+	}
+}
+
 // Map transforms the keys and values within the iterator using the provided Mapper function.
 func (s KVMap3[K1, V1, K2, V2, K3, V3, K4, V4]) FilterMap(Mapper FilteringMapper2[K1, V1, K2, V2]) KVMap2[K2, V2, K3, V3, K4, V4] {
 	return func(yield Yielder2[K2, V2]) {
@@ -310,6 +643,11 @@ func (s KVMap3[K1, V1, K2, V2, K3, V3, K4, V4]) FilterMap(Mapper FilteringMapper
 	}
 }
 
+// See [KVSeq.Chain].
+func (s KVMap3[K1, V1, K2, V2, K3, V3, K4, V4]) Chain(others ...KVSeq[K1, V1]) KVMap3[K1, V1, K2, V2, K3, V3, K4, V4] {
+	return KVMap3[K1, V1, K2, V2, K3, V3, K4, V4](KVSeq[K1, V1](s).Chain(others...))
+}
+
 // See [KVSeq.Filter].
 func (s KVMap3[K1, V1, K2, V2, K3, V3, K4, V4]) Filter(filter Yielder2[K1, V1]) KVMap3[K1, V1, K2, V2, K3, V3, K4, V4] {
 	return KVMap3[K1, V1, K2, V2, K3, V3, K4, V4](KVSeq[K1, V1](s).Filter(filter))
@@ -325,6 +663,16 @@ func (s KVMap3[K1, V1, K2, V2, K3, V3, K4, V4]) SkipWhile(test Yielder2[K1, V1])
 	return KVMap3[K1, V1, K2, V2, K3, V3, K4, V4](KVSeq[K1, V1](s).SkipWhile(test))
 }
 
+// See [KVSeq.SortedByKey].
+func (s KVMap3[K1, V1, K2, V2, K3, V3, K4, V4]) SortedByKey(compare func(a, b K1) int) KVMap3[K1, V1, K2, V2, K3, V3, K4, V4] {
+	return KVMap3[K1, V1, K2, V2, K3, V3, K4, V4](KVSeq[K1, V1](s).SortedByKey(compare))
+}
+
+// See [KVSeq.SortedByValue].
+func (s KVMap3[K1, V1, K2, V2, K3, V3, K4, V4]) SortedByValue(compare func(a, b V1) int) KVMap3[K1, V1, K2, V2, K3, V3, K4, V4] {
+	return KVMap3[K1, V1, K2, V2, K3, V3, K4, V4](KVSeq[K1, V1](s).SortedByValue(compare))
+}
+
 // See [KVSeq.Take].
 func (s KVMap3[K1, V1, K2, V2, K3, V3, K4, V4]) Take(toTake int) KVMap3[K1, V1, K2, V2, K3, V3, K4, V4] {
 	return KVMap3[K1, V1, K2, V2, K3, V3, K4, V4](KVSeq[K1, V1](s).Take(toTake))
@@ -335,6 +683,11 @@ func (s KVMap3[K1, V1, K2, V2, K3, V3, K4, V4]) TakeWhile(test Yielder2[K1, V1])
 	return KVMap3[K1, V1, K2, V2, K3, V3, K4, V4](KVSeq[K1, V1](s).TakeWhile(test))
 }
 
+// See [KVSeq.WithContext].
+func (s KVMap3[K1, V1, K2, V2, K3, V3, K4, V4]) WithContext(ctx context.Context) KVMap3[K1, V1, K2, V2, K3, V3, K4, V4] {
+	return KVMap3[K1, V1, K2, V2, K3, V3, K4, V4](KVSeq[K1, V1](s).WithContext(ctx))
+}
+
 type Map4[V1, V2, V3, V4, V5 any] Map3[V1, V2, V3, V4]
 
 // Map transforms the elements within the iterator using the provided Mapper function.
@@ -346,6 +699,24 @@ func (s Map4[V1, V2, V3, V4, V5]) Map(Mapper Mapper[V1, V2]) Map3[V2, V3, V4, V5
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s Map4[V1, V2, V3, V4, V5]) MapErr(Mapper FilteringMapperErr[V1, V2]) Map3[V2, V3, V4, V5] {
+	return func(yield Yielder[V2]) {
+		s(func(v V1) bool {
+			mapped, err := Mapper(v)
+			PanicHaltIteration(err)
+			return yield(mapped)
+		})
+	}
+}
+
+// ParallelMap is identical to Map, except the Mapper is called concurrently on
+// a pool of workers goroutines. See [loz.Seq.ParallelMap].
+func (s Map4[V1, V2, V3, V4, V5]) ParallelMap(workers int, Mapper Mapper[V1, V2]) Map3[V2, V3, V4, V5] {
+	return ParallelMap(s, workers, Mapper)
+}
+
 func (s Map4[V1, V2, V3, V4, V5]) FilterMap(Mapper FilteringMapper[V1, V2]) Map3[V2, V3, V4, V5] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -358,6 +729,38 @@ func (s Map4[V1, V2, V3, V4, V5]) FilterMap(Mapper FilteringMapper[V1, V2]) Map3
 	}
 }
 
+// MapChunks transforms consecutive batches of elements using the provided
+// Mapper function. See [loz.Chunk].
+func (s Map4[V1, V2, V3, V4, V5]) MapChunks(n int, Mapper Mapper[[]V1, V2]) Map3[V2, V3, V4, V5] {
+	return func(yield Yielder[V2]) {
+		Chunk(Seq[V1](s), n)(func(chunk []V1) bool {
+			return yield(Mapper(chunk))
+		})
+	}
+}
+
+// MapWindows transforms sliding windows of elements using the provided Mapper
+// function. See [loz.Window].
+func (s Map4[V1, V2, V3, V4, V5]) MapWindows(size, step int, Mapper Mapper[[]V1, V2]) Map3[V2, V3, V4, V5] {
+	return func(yield Yielder[V2]) {
+		Window(Seq[V1](s), size, step)(func(window []V1) bool {
+			return yield(Mapper(window))
+		})
+	}
+}
+
+// Scan is identical to [loz.Seq.Scan] except that the type of the accumulator
+// can be different than the type of the elements in the sequence.
+func (s Map4[V1, V2, V3, V4, V5]) Scan(initial V2, combine Reducer[V1, V2]) Map3[V2, V3, V4, V5] {
+	return func(yield Yielder[V2]) {
+		acc := initial
+		s(func(v V1) bool {
+			acc = combine(acc, v)
+			return yield(acc)
+		})
+	}
+}
+
 func (s Map4[V1, V2, V3, V4, V5]) Expand(toElements Mapper[V1, Seq[V2]]) Map3[V2, V3, V4, V5] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -371,6 +774,21 @@ func (s Map4[V1, V2, V3, V4, V5]) Expand(toElements Mapper[V1, Seq[V2]]) Map3[V2
 	}
 }
 
+// See [loz.Seq.Chain].
+func (s Map4[V1, V2, V3, V4, V5]) Chain(others ...Seq[V1]) Map4[V1, V2, V3, V4, V5] {
+	return Map4[V1, V2, V3, V4, V5](Seq[V1](s).Chain(others...))
+}
+
+// See [loz.Seq.Cycle].
+func (s Map4[V1, V2, V3, V4, V5]) Cycle() Map4[V1, V2, V3, V4, V5] {
+	return Map4[V1, V2, V3, V4, V5](Seq[V1](s).Cycle())
+}
+
+// See [loz.Seq.DedupFunc].
+func (s Map4[V1, V2, V3, V4, V5]) DedupFunc(equal func(a, b V1) bool) Map4[V1, V2, V3, V4, V5] {
+	return Map4[V1, V2, V3, V4, V5](Seq[V1](s).DedupFunc(equal))
+}
+
 // See [loz.Seq.Filter].
 func (s Map4[V1, V2, V3, V4, V5]) Filter(filter Yielder[V1]) Map4[V1, V2, V3, V4, V5] {
 	return Map4[V1, V2, V3, V4, V5](Seq[V1](s).Filter(filter))
@@ -386,6 +804,11 @@ func (s Map4[V1, V2, V3, V4, V5]) SkipWhile(test Yielder[V1]) Map4[V1, V2, V3, V
 	return Map4[V1, V2, V3, V4, V5](Seq[V1](s).SkipWhile(test))
 }
 
+// See [loz.Seq.SortedFunc].
+func (s Map4[V1, V2, V3, V4, V5]) SortedFunc(compare func(a, b V1) int) Map4[V1, V2, V3, V4, V5] {
+	return Map4[V1, V2, V3, V4, V5](Seq[V1](s).SortedFunc(compare))
+}
+
 // See [loz.Seq.Take].
 func (s Map4[V1, V2, V3, V4, V5]) Take(toTake int) Map4[V1, V2, V3, V4, V5] {
 	return Map4[V1, V2, V3, V4, V5](Seq[V1](s).Take(toTake))
@@ -396,6 +819,21 @@ func (s Map4[V1, V2, V3, V4, V5]) TakeWhile(test Yielder[V1]) Map4[V1, V2, V3, V
 	return Map4[V1, V2, V3, V4, V5](Seq[V1](s).TakeWhile(test))
 }
 
+// See [loz.Seq.ParallelFilter].
+func (s Map4[V1, V2, V3, V4, V5]) ParallelFilter(workers int, filter Yielder[V1]) Map4[V1, V2, V3, V4, V5] {
+	return Map4[V1, V2, V3, V4, V5](Seq[V1](s).ParallelFilter(workers, filter))
+}
+
+// See [loz.Seq.TopK].
+func (s Map4[V1, V2, V3, V4, V5]) TopK(n int, compare func(a, b V1) int) Map4[V1, V2, V3, V4, V5] {
+	return Map4[V1, V2, V3, V4, V5](Seq[V1](s).TopK(n, compare))
+}
+
+// See [loz.Seq.WithContext].
+func (s Map4[V1, V2, V3, V4, V5]) WithContext(ctx context.Context) Map4[V1, V2, V3, V4, V5] {
+	return Map4[V1, V2, V3, V4, V5](Seq[V1](s).WithContext(ctx))
+}
+
 type KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5 any] KVMap3[K1, V1, K2, V2, K3, V3, K4, V4]
 
 // Map transforms the keys and values within the iterator using the provided Mapper function.
@@ -407,6 +845,18 @@ func (s KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5]) Map(Mapper Mapper2[K1, V
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5]) MapErr(Mapper FilteringMapperErr2[K1, V1, K2, V2]) KVMap3[K2, V2, K3, V3, K4, V4, K5, V5] {
+	return func(yield Yielder2[K2, V2]) {
+		s(func(k K1, v V1) bool {
+			mk, mv, err := Mapper(k, v)
+			PanicHaltIteration(err)
+			return yield(mk, mv)
+		})
+	}
+}
+
 // Map transforms the keys and values within the iterator using the provided Mapper function.
 func (s KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5]) FilterMap(Mapper FilteringMapper2[K1, V1, K2, V2]) KVMap3[K2, V2, K3, V3, K4, V4, K5, V5] {
 	return func(yield Yielder2[K2, V2]) {
@@ -420,6 +870,11 @@ func (s KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5]) FilterMap(Mapper Filteri
 	}
 }
 
+// See [KVSeq.Chain].
+func (s KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5]) Chain(others ...KVSeq[K1, V1]) KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5] {
+	return KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5](KVSeq[K1, V1](s).Chain(others...))
+}
+
 // See [KVSeq.Filter].
 func (s KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5]) Filter(filter Yielder2[K1, V1]) KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5] {
 	return KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5](KVSeq[K1, V1](s).Filter(filter))
@@ -435,6 +890,16 @@ func (s KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5]) SkipWhile(test Yielder2[
 	return KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5](KVSeq[K1, V1](s).SkipWhile(test))
 }
 
+// See [KVSeq.SortedByKey].
+func (s KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5]) SortedByKey(compare func(a, b K1) int) KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5] {
+	return KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5](KVSeq[K1, V1](s).SortedByKey(compare))
+}
+
+// See [KVSeq.SortedByValue].
+func (s KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5]) SortedByValue(compare func(a, b V1) int) KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5] {
+	return KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5](KVSeq[K1, V1](s).SortedByValue(compare))
+}
+
 // See [KVSeq.Take].
 func (s KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5]) Take(toTake int) KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5] {
 	return KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5](KVSeq[K1, V1](s).Take(toTake))
@@ -445,6 +910,11 @@ func (s KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5]) TakeWhile(test Yielder2[
 	return KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5](KVSeq[K1, V1](s).TakeWhile(test))
 }
 
+// See [KVSeq.WithContext].
+func (s KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5]) WithContext(ctx context.Context) KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5] {
+	return KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5](KVSeq[K1, V1](s).WithContext(ctx))
+}
+
 type Map5[V1, V2, V3, V4, V5, V6 any] Map4[V1, V2, V3, V4, V5]
 
 // Map transforms the elements within the iterator using the provided Mapper function.
@@ -456,6 +926,24 @@ func (s Map5[V1, V2, V3, V4, V5, V6]) Map(Mapper Mapper[V1, V2]) Map4[V2, V3, V4
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s Map5[V1, V2, V3, V4, V5, V6]) MapErr(Mapper FilteringMapperErr[V1, V2]) Map4[V2, V3, V4, V5, V6] {
+	return func(yield Yielder[V2]) {
+		s(func(v V1) bool {
+			mapped, err := Mapper(v)
+			PanicHaltIteration(err)
+			return yield(mapped)
+		})
+	}
+}
+
+// ParallelMap is identical to Map, except the Mapper is called concurrently on
+// a pool of workers goroutines. See [loz.Seq.ParallelMap].
+func (s Map5[V1, V2, V3, V4, V5, V6]) ParallelMap(workers int, Mapper Mapper[V1, V2]) Map4[V2, V3, V4, V5, V6] {
+	return ParallelMap(s, workers, Mapper)
+}
+
 func (s Map5[V1, V2, V3, V4, V5, V6]) FilterMap(Mapper FilteringMapper[V1, V2]) Map4[V2, V3, V4, V5, V6] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -468,6 +956,38 @@ func (s Map5[V1, V2, V3, V4, V5, V6]) FilterMap(Mapper FilteringMapper[V1, V2])
 	}
 }
 
+// MapChunks transforms consecutive batches of elements using the provided
+// Mapper function. See [loz.Chunk].
+func (s Map5[V1, V2, V3, V4, V5, V6]) MapChunks(n int, Mapper Mapper[[]V1, V2]) Map4[V2, V3, V4, V5, V6] {
+	return func(yield Yielder[V2]) {
+		Chunk(Seq[V1](s), n)(func(chunk []V1) bool {
+			return yield(Mapper(chunk))
+		})
+	}
+}
+
+// MapWindows transforms sliding windows of elements using the provided Mapper
+// function. See [loz.Window].
+func (s Map5[V1, V2, V3, V4, V5, V6]) MapWindows(size, step int, Mapper Mapper[[]V1, V2]) Map4[V2, V3, V4, V5, V6] {
+	return func(yield Yielder[V2]) {
+		Window(Seq[V1](s), size, step)(func(window []V1) bool {
+			return yield(Mapper(window))
+		})
+	}
+}
+
+// Scan is identical to [loz.Seq.Scan] except that the type of the accumulator
+// can be different than the type of the elements in the sequence.
+func (s Map5[V1, V2, V3, V4, V5, V6]) Scan(initial V2, combine Reducer[V1, V2]) Map4[V2, V3, V4, V5, V6] {
+	return func(yield Yielder[V2]) {
+		acc := initial
+		s(func(v V1) bool {
+			acc = combine(acc, v)
+			return yield(acc)
+		})
+	}
+}
+
 func (s Map5[V1, V2, V3, V4, V5, V6]) Expand(toElements Mapper[V1, Seq[V2]]) Map4[V2, V3, V4, V5, V6] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -481,6 +1001,21 @@ func (s Map5[V1, V2, V3, V4, V5, V6]) Expand(toElements Mapper[V1, Seq[V2]]) Map
 	}
 }
 
+// See [loz.Seq.Chain].
+func (s Map5[V1, V2, V3, V4, V5, V6]) Chain(others ...Seq[V1]) Map5[V1, V2, V3, V4, V5, V6] {
+	return Map5[V1, V2, V3, V4, V5, V6](Seq[V1](s).Chain(others...))
+}
+
+// See [loz.Seq.Cycle].
+func (s Map5[V1, V2, V3, V4, V5, V6]) Cycle() Map5[V1, V2, V3, V4, V5, V6] {
+	return Map5[V1, V2, V3, V4, V5, V6](Seq[V1](s).Cycle())
+}
+
+// See [loz.Seq.DedupFunc].
+func (s Map5[V1, V2, V3, V4, V5, V6]) DedupFunc(equal func(a, b V1) bool) Map5[V1, V2, V3, V4, V5, V6] {
+	return Map5[V1, V2, V3, V4, V5, V6](Seq[V1](s).DedupFunc(equal))
+}
+
 // See [loz.Seq.Filter].
 func (s Map5[V1, V2, V3, V4, V5, V6]) Filter(filter Yielder[V1]) Map5[V1, V2, V3, V4, V5, V6] {
 	return Map5[V1, V2, V3, V4, V5, V6](Seq[V1](s).Filter(filter))
@@ -496,6 +1031,11 @@ func (s Map5[V1, V2, V3, V4, V5, V6]) SkipWhile(test Yielder[V1]) Map5[V1, V2, V
 	return Map5[V1, V2, V3, V4, V5, V6](Seq[V1](s).SkipWhile(test))
 }
 
+// See [loz.Seq.SortedFunc].
+func (s Map5[V1, V2, V3, V4, V5, V6]) SortedFunc(compare func(a, b V1) int) Map5[V1, V2, V3, V4, V5, V6] {
+	return Map5[V1, V2, V3, V4, V5, V6](Seq[V1](s).SortedFunc(compare))
+}
+
 // See [loz.Seq.Take].
 func (s Map5[V1, V2, V3, V4, V5, V6]) Take(toTake int) Map5[V1, V2, V3, V4, V5, V6] {
 	return Map5[V1, V2, V3, V4, V5, V6](Seq[V1](s).Take(toTake))
@@ -506,6 +1046,21 @@ func (s Map5[V1, V2, V3, V4, V5, V6]) TakeWhile(test Yielder[V1]) Map5[V1, V2, V
 	return Map5[V1, V2, V3, V4, V5, V6](Seq[V1](s).TakeWhile(test))
 }
 
+// See [loz.Seq.ParallelFilter].
+func (s Map5[V1, V2, V3, V4, V5, V6]) ParallelFilter(workers int, filter Yielder[V1]) Map5[V1, V2, V3, V4, V5, V6] {
+	return Map5[V1, V2, V3, V4, V5, V6](Seq[V1](s).ParallelFilter(workers, filter))
+}
+
+// See [loz.Seq.TopK].
+func (s Map5[V1, V2, V3, V4, V5, V6]) TopK(n int, compare func(a, b V1) int) Map5[V1, V2, V3, V4, V5, V6] {
+	return Map5[V1, V2, V3, V4, V5, V6](Seq[V1](s).TopK(n, compare))
+}
+
+// See [loz.Seq.WithContext].
+func (s Map5[V1, V2, V3, V4, V5, V6]) WithContext(ctx context.Context) Map5[V1, V2, V3, V4, V5, V6] {
+	return Map5[V1, V2, V3, V4, V5, V6](Seq[V1](s).WithContext(ctx))
+}
+
 type KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6 any] KVMap4[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5]
 
 // Map transforms the keys and values within the iterator using the provided Mapper function.
@@ -517,6 +1072,18 @@ func (s KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6]) Map(Mapper Mappe
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6]) MapErr(Mapper FilteringMapperErr2[K1, V1, K2, V2]) KVMap4[K2, V2, K3, V3, K4, V4, K5, V5, K6, V6] {
+	return func(yield Yielder2[K2, V2]) {
+		s(func(k K1, v V1) bool {
+			mk, mv, err := Mapper(k, v)
+			PanicHaltIteration(err)
+			return yield(mk, mv)
+		})
+	}
+}
+
 // Map transforms the keys and values within the iterator using the provided Mapper function.
 func (s KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6]) FilterMap(Mapper FilteringMapper2[K1, V1, K2, V2]) KVMap4[K2, V2, K3, V3, K4, V4, K5, V5, K6, V6] {
 	return func(yield Yielder2[K2, V2]) {
@@ -530,6 +1097,11 @@ func (s KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6]) FilterMap(Mapper
 	}
 }
 
+// See [KVSeq.Chain].
+func (s KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6]) Chain(others ...KVSeq[K1, V1]) KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6] {
+	return KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6](KVSeq[K1, V1](s).Chain(others...))
+}
+
 // See [KVSeq.Filter].
 func (s KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6]) Filter(filter Yielder2[K1, V1]) KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6] {
 	return KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6](KVSeq[K1, V1](s).Filter(filter))
@@ -545,6 +1117,16 @@ func (s KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6]) SkipWhile(test Y
 	return KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6](KVSeq[K1, V1](s).SkipWhile(test))
 }
 
+// See [KVSeq.SortedByKey].
+func (s KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6]) SortedByKey(compare func(a, b K1) int) KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6] {
+	return KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6](KVSeq[K1, V1](s).SortedByKey(compare))
+}
+
+// See [KVSeq.SortedByValue].
+func (s KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6]) SortedByValue(compare func(a, b V1) int) KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6] {
+	return KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6](KVSeq[K1, V1](s).SortedByValue(compare))
+}
+
 // See [KVSeq.Take].
 func (s KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6]) Take(toTake int) KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6] {
 	return KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6](KVSeq[K1, V1](s).Take(toTake))
@@ -555,6 +1137,11 @@ func (s KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6]) TakeWhile(test Y
 	return KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6](KVSeq[K1, V1](s).TakeWhile(test))
 }
 
+// See [KVSeq.WithContext].
+func (s KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6]) WithContext(ctx context.Context) KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6] {
+	return KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6](KVSeq[K1, V1](s).WithContext(ctx))
+}
+
 type Map6[V1, V2, V3, V4, V5, V6, V7 any] Map5[V1, V2, V3, V4, V5, V6]
 
 // Map transforms the elements within the iterator using the provided Mapper function.
@@ -566,6 +1153,24 @@ func (s Map6[V1, V2, V3, V4, V5, V6, V7]) Map(Mapper Mapper[V1, V2]) Map5[V2, V3
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s Map6[V1, V2, V3, V4, V5, V6, V7]) MapErr(Mapper FilteringMapperErr[V1, V2]) Map5[V2, V3, V4, V5, V6, V7] {
+	return func(yield Yielder[V2]) {
+		s(func(v V1) bool {
+			mapped, err := Mapper(v)
+			PanicHaltIteration(err)
+			return yield(mapped)
+		})
+	}
+}
+
+// ParallelMap is identical to Map, except the Mapper is called concurrently on
+// a pool of workers goroutines. See [loz.Seq.ParallelMap].
+func (s Map6[V1, V2, V3, V4, V5, V6, V7]) ParallelMap(workers int, Mapper Mapper[V1, V2]) Map5[V2, V3, V4, V5, V6, V7] {
+	return ParallelMap(s, workers, Mapper)
+}
+
 func (s Map6[V1, V2, V3, V4, V5, V6, V7]) FilterMap(Mapper FilteringMapper[V1, V2]) Map5[V2, V3, V4, V5, V6, V7] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -578,6 +1183,38 @@ func (s Map6[V1, V2, V3, V4, V5, V6, V7]) FilterMap(Mapper FilteringMapper[V1, V
 	}
 }
 
+// MapChunks transforms consecutive batches of elements using the provided
+// Mapper function. See [loz.Chunk].
+func (s Map6[V1, V2, V3, V4, V5, V6, V7]) MapChunks(n int, Mapper Mapper[[]V1, V2]) Map5[V2, V3, V4, V5, V6, V7] {
+	return func(yield Yielder[V2]) {
+		Chunk(Seq[V1](s), n)(func(chunk []V1) bool {
+			return yield(Mapper(chunk))
+		})
+	}
+}
+
+// MapWindows transforms sliding windows of elements using the provided Mapper
+// function. See [loz.Window].
+func (s Map6[V1, V2, V3, V4, V5, V6, V7]) MapWindows(size, step int, Mapper Mapper[[]V1, V2]) Map5[V2, V3, V4, V5, V6, V7] {
+	return func(yield Yielder[V2]) {
+		Window(Seq[V1](s), size, step)(func(window []V1) bool {
+			return yield(Mapper(window))
+		})
+	}
+}
+
+// Scan is identical to [loz.Seq.Scan] except that the type of the accumulator
+// can be different than the type of the elements in the sequence.
+func (s Map6[V1, V2, V3, V4, V5, V6, V7]) Scan(initial V2, combine Reducer[V1, V2]) Map5[V2, V3, V4, V5, V6, V7] {
+	return func(yield Yielder[V2]) {
+		acc := initial
+		s(func(v V1) bool {
+			acc = combine(acc, v)
+			return yield(acc)
+		})
+	}
+}
+
 func (s Map6[V1, V2, V3, V4, V5, V6, V7]) Expand(toElements Mapper[V1, Seq[V2]]) Map5[V2, V3, V4, V5, V6, V7] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -591,6 +1228,21 @@ func (s Map6[V1, V2, V3, V4, V5, V6, V7]) Expand(toElements Mapper[V1, Seq[V2]])
 	}
 }
 
+// See [loz.Seq.Chain].
+func (s Map6[V1, V2, V3, V4, V5, V6, V7]) Chain(others ...Seq[V1]) Map6[V1, V2, V3, V4, V5, V6, V7] {
+	return Map6[V1, V2, V3, V4, V5, V6, V7](Seq[V1](s).Chain(others...))
+}
+
+// See [loz.Seq.Cycle].
+func (s Map6[V1, V2, V3, V4, V5, V6, V7]) Cycle() Map6[V1, V2, V3, V4, V5, V6, V7] {
+	return Map6[V1, V2, V3, V4, V5, V6, V7](Seq[V1](s).Cycle())
+}
+
+// See [loz.Seq.DedupFunc].
+func (s Map6[V1, V2, V3, V4, V5, V6, V7]) DedupFunc(equal func(a, b V1) bool) Map6[V1, V2, V3, V4, V5, V6, V7] {
+	return Map6[V1, V2, V3, V4, V5, V6, V7](Seq[V1](s).DedupFunc(equal))
+}
+
 // See [loz.Seq.Filter].
 func (s Map6[V1, V2, V3, V4, V5, V6, V7]) Filter(filter Yielder[V1]) Map6[V1, V2, V3, V4, V5, V6, V7] {
 	return Map6[V1, V2, V3, V4, V5, V6, V7](Seq[V1](s).Filter(filter))
@@ -606,6 +1258,11 @@ func (s Map6[V1, V2, V3, V4, V5, V6, V7]) SkipWhile(test Yielder[V1]) Map6[V1, V
 	return Map6[V1, V2, V3, V4, V5, V6, V7](Seq[V1](s).SkipWhile(test))
 }
 
+// See [loz.Seq.SortedFunc].
+func (s Map6[V1, V2, V3, V4, V5, V6, V7]) SortedFunc(compare func(a, b V1) int) Map6[V1, V2, V3, V4, V5, V6, V7] {
+	return Map6[V1, V2, V3, V4, V5, V6, V7](Seq[V1](s).SortedFunc(compare))
+}
+
 // See [loz.Seq.Take].
 func (s Map6[V1, V2, V3, V4, V5, V6, V7]) Take(toTake int) Map6[V1, V2, V3, V4, V5, V6, V7] {
 	return Map6[V1, V2, V3, V4, V5, V6, V7](Seq[V1](s).Take(toTake))
@@ -616,6 +1273,21 @@ func (s Map6[V1, V2, V3, V4, V5, V6, V7]) TakeWhile(test Yielder[V1]) Map6[V1, V
 	return Map6[V1, V2, V3, V4, V5, V6, V7](Seq[V1](s).TakeWhile(test))
 }
 
+// See [loz.Seq.ParallelFilter].
+func (s Map6[V1, V2, V3, V4, V5, V6, V7]) ParallelFilter(workers int, filter Yielder[V1]) Map6[V1, V2, V3, V4, V5, V6, V7] {
+	return Map6[V1, V2, V3, V4, V5, V6, V7](Seq[V1](s).ParallelFilter(workers, filter))
+}
+
+// See [loz.Seq.TopK].
+func (s Map6[V1, V2, V3, V4, V5, V6, V7]) TopK(n int, compare func(a, b V1) int) Map6[V1, V2, V3, V4, V5, V6, V7] {
+	return Map6[V1, V2, V3, V4, V5, V6, V7](Seq[V1](s).TopK(n, compare))
+}
+
+// See [loz.Seq.WithContext].
+func (s Map6[V1, V2, V3, V4, V5, V6, V7]) WithContext(ctx context.Context) Map6[V1, V2, V3, V4, V5, V6, V7] {
+	return Map6[V1, V2, V3, V4, V5, V6, V7](Seq[V1](s).WithContext(ctx))
+}
+
 type KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7 any] KVMap5[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6]
 
 // Map transforms the keys and values within the iterator using the provided Mapper function.
@@ -627,6 +1299,18 @@ func (s KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7]) Map(Mapp
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7]) MapErr(Mapper FilteringMapperErr2[K1, V1, K2, V2]) KVMap5[K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7] {
+	return func(yield Yielder2[K2, V2]) {
+		s(func(k K1, v V1) bool {
+			mk, mv, err := Mapper(k, v)
+			PanicHaltIteration(err)
+			return yield(mk, mv)
+		})
+	}
+}
+
 // Map transforms the keys and values within the iterator using the provided Mapper function.
 func (s KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7]) FilterMap(Mapper FilteringMapper2[K1, V1, K2, V2]) KVMap5[K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7] {
 	return func(yield Yielder2[K2, V2]) {
@@ -640,6 +1324,11 @@ func (s KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7]) FilterMa
 	}
 }
 
+// See [KVSeq.Chain].
+func (s KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7]) Chain(others ...KVSeq[K1, V1]) KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7] {
+	return KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7](KVSeq[K1, V1](s).Chain(others...))
+}
+
 // See [KVSeq.Filter].
 func (s KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7]) Filter(filter Yielder2[K1, V1]) KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7] {
 	return KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7](KVSeq[K1, V1](s).Filter(filter))
@@ -655,6 +1344,16 @@ func (s KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7]) SkipWhil
 	return KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7](KVSeq[K1, V1](s).SkipWhile(test))
 }
 
+// See [KVSeq.SortedByKey].
+func (s KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7]) SortedByKey(compare func(a, b K1) int) KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7] {
+	return KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7](KVSeq[K1, V1](s).SortedByKey(compare))
+}
+
+// See [KVSeq.SortedByValue].
+func (s KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7]) SortedByValue(compare func(a, b V1) int) KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7] {
+	return KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7](KVSeq[K1, V1](s).SortedByValue(compare))
+}
+
 // See [KVSeq.Take].
 func (s KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7]) Take(toTake int) KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7] {
 	return KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7](KVSeq[K1, V1](s).Take(toTake))
@@ -665,6 +1364,11 @@ func (s KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7]) TakeWhil
 	return KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7](KVSeq[K1, V1](s).TakeWhile(test))
 }
 
+// See [KVSeq.WithContext].
+func (s KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7]) WithContext(ctx context.Context) KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7] {
+	return KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7](KVSeq[K1, V1](s).WithContext(ctx))
+}
+
 type Map7[V1, V2, V3, V4, V5, V6, V7, V8 any] Map6[V1, V2, V3, V4, V5, V6, V7]
 
 // Map transforms the elements within the iterator using the provided Mapper function.
@@ -676,6 +1380,24 @@ func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) Map(Mapper Mapper[V1, V2]) Map6[V2
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) MapErr(Mapper FilteringMapperErr[V1, V2]) Map6[V2, V3, V4, V5, V6, V7, V8] {
+	return func(yield Yielder[V2]) {
+		s(func(v V1) bool {
+			mapped, err := Mapper(v)
+			PanicHaltIteration(err)
+			return yield(mapped)
+		})
+	}
+}
+
+// ParallelMap is identical to Map, except the Mapper is called concurrently on
+// a pool of workers goroutines. See [loz.Seq.ParallelMap].
+func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) ParallelMap(workers int, Mapper Mapper[V1, V2]) Map6[V2, V3, V4, V5, V6, V7, V8] {
+	return ParallelMap(s, workers, Mapper)
+}
+
 func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) FilterMap(Mapper FilteringMapper[V1, V2]) Map6[V2, V3, V4, V5, V6, V7, V8] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -688,6 +1410,38 @@ func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) FilterMap(Mapper FilteringMapper[V
 	}
 }
 
+// MapChunks transforms consecutive batches of elements using the provided
+// Mapper function. See [loz.Chunk].
+func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) MapChunks(n int, Mapper Mapper[[]V1, V2]) Map6[V2, V3, V4, V5, V6, V7, V8] {
+	return func(yield Yielder[V2]) {
+		Chunk(Seq[V1](s), n)(func(chunk []V1) bool {
+			return yield(Mapper(chunk))
+		})
+	}
+}
+
+// MapWindows transforms sliding windows of elements using the provided Mapper
+// function. See [loz.Window].
+func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) MapWindows(size, step int, Mapper Mapper[[]V1, V2]) Map6[V2, V3, V4, V5, V6, V7, V8] {
+	return func(yield Yielder[V2]) {
+		Window(Seq[V1](s), size, step)(func(window []V1) bool {
+			return yield(Mapper(window))
+		})
+	}
+}
+
+// Scan is identical to [loz.Seq.Scan] except that the type of the accumulator
+// can be different than the type of the elements in the sequence.
+func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) Scan(initial V2, combine Reducer[V1, V2]) Map6[V2, V3, V4, V5, V6, V7, V8] {
+	return func(yield Yielder[V2]) {
+		acc := initial
+		s(func(v V1) bool {
+			acc = combine(acc, v)
+			return yield(acc)
+		})
+	}
+}
+
 func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) Expand(toElements Mapper[V1, Seq[V2]]) Map6[V2, V3, V4, V5, V6, V7, V8] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -701,6 +1455,21 @@ func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) Expand(toElements Mapper[V1, Seq[V
 	}
 }
 
+// See [loz.Seq.Chain].
+func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) Chain(others ...Seq[V1]) Map7[V1, V2, V3, V4, V5, V6, V7, V8] {
+	return Map7[V1, V2, V3, V4, V5, V6, V7, V8](Seq[V1](s).Chain(others...))
+}
+
+// See [loz.Seq.Cycle].
+func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) Cycle() Map7[V1, V2, V3, V4, V5, V6, V7, V8] {
+	return Map7[V1, V2, V3, V4, V5, V6, V7, V8](Seq[V1](s).Cycle())
+}
+
+// See [loz.Seq.DedupFunc].
+func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) DedupFunc(equal func(a, b V1) bool) Map7[V1, V2, V3, V4, V5, V6, V7, V8] {
+	return Map7[V1, V2, V3, V4, V5, V6, V7, V8](Seq[V1](s).DedupFunc(equal))
+}
+
 // See [loz.Seq.Filter].
 func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) Filter(filter Yielder[V1]) Map7[V1, V2, V3, V4, V5, V6, V7, V8] {
 	return Map7[V1, V2, V3, V4, V5, V6, V7, V8](Seq[V1](s).Filter(filter))
@@ -716,6 +1485,11 @@ func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) SkipWhile(test Yielder[V1]) Map7[V
 	return Map7[V1, V2, V3, V4, V5, V6, V7, V8](Seq[V1](s).SkipWhile(test))
 }
 
+// See [loz.Seq.SortedFunc].
+func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) SortedFunc(compare func(a, b V1) int) Map7[V1, V2, V3, V4, V5, V6, V7, V8] {
+	return Map7[V1, V2, V3, V4, V5, V6, V7, V8](Seq[V1](s).SortedFunc(compare))
+}
+
 // See [loz.Seq.Take].
 func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) Take(toTake int) Map7[V1, V2, V3, V4, V5, V6, V7, V8] {
 	return Map7[V1, V2, V3, V4, V5, V6, V7, V8](Seq[V1](s).Take(toTake))
@@ -726,6 +1500,21 @@ func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) TakeWhile(test Yielder[V1]) Map7[V
 	return Map7[V1, V2, V3, V4, V5, V6, V7, V8](Seq[V1](s).TakeWhile(test))
 }
 
+// See [loz.Seq.ParallelFilter].
+func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) ParallelFilter(workers int, filter Yielder[V1]) Map7[V1, V2, V3, V4, V5, V6, V7, V8] {
+	return Map7[V1, V2, V3, V4, V5, V6, V7, V8](Seq[V1](s).ParallelFilter(workers, filter))
+}
+
+// See [loz.Seq.TopK].
+func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) TopK(n int, compare func(a, b V1) int) Map7[V1, V2, V3, V4, V5, V6, V7, V8] {
+	return Map7[V1, V2, V3, V4, V5, V6, V7, V8](Seq[V1](s).TopK(n, compare))
+}
+
+// See [loz.Seq.WithContext].
+func (s Map7[V1, V2, V3, V4, V5, V6, V7, V8]) WithContext(ctx context.Context) Map7[V1, V2, V3, V4, V5, V6, V7, V8] {
+	return Map7[V1, V2, V3, V4, V5, V6, V7, V8](Seq[V1](s).WithContext(ctx))
+}
+
 type KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8 any] KVMap6[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7]
 
 // Map transforms the keys and values within the iterator using the provided Mapper function.
@@ -737,6 +1526,18 @@ func (s KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8])
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8]) MapErr(Mapper FilteringMapperErr2[K1, V1, K2, V2]) KVMap6[K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8] {
+	return func(yield Yielder2[K2, V2]) {
+		s(func(k K1, v V1) bool {
+			mk, mv, err := Mapper(k, v)
+			PanicHaltIteration(err)
+			return yield(mk, mv)
+		})
+	}
+}
+
 // Map transforms the keys and values within the iterator using the provided Mapper function.
 func (s KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8]) FilterMap(Mapper FilteringMapper2[K1, V1, K2, V2]) KVMap6[K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8] {
 	return func(yield Yielder2[K2, V2]) {
@@ -750,6 +1551,11 @@ func (s KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8])
 	}
 }
 
+// See [KVSeq.Chain].
+func (s KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8]) Chain(others ...KVSeq[K1, V1]) KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8] {
+	return KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8](KVSeq[K1, V1](s).Chain(others...))
+}
+
 // See [KVSeq.Filter].
 func (s KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8]) Filter(filter Yielder2[K1, V1]) KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8] {
 	return KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8](KVSeq[K1, V1](s).Filter(filter))
@@ -765,6 +1571,16 @@ func (s KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8])
 	return KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8](KVSeq[K1, V1](s).SkipWhile(test))
 }
 
+// See [KVSeq.SortedByKey].
+func (s KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8]) SortedByKey(compare func(a, b K1) int) KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8] {
+	return KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8](KVSeq[K1, V1](s).SortedByKey(compare))
+}
+
+// See [KVSeq.SortedByValue].
+func (s KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8]) SortedByValue(compare func(a, b V1) int) KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8] {
+	return KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8](KVSeq[K1, V1](s).SortedByValue(compare))
+}
+
 // See [KVSeq.Take].
 func (s KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8]) Take(toTake int) KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8] {
 	return KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8](KVSeq[K1, V1](s).Take(toTake))
@@ -775,6 +1591,11 @@ func (s KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8])
 	return KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8](KVSeq[K1, V1](s).TakeWhile(test))
 }
 
+// See [KVSeq.WithContext].
+func (s KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8]) WithContext(ctx context.Context) KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8] {
+	return KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8](KVSeq[K1, V1](s).WithContext(ctx))
+}
+
 type Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9 any] Map7[V1, V2, V3, V4, V5, V6, V7, V8]
 
 // Map transforms the elements within the iterator using the provided Mapper function.
@@ -786,6 +1607,24 @@ func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) Map(Mapper Mapper[V1, V2]) Map
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) MapErr(Mapper FilteringMapperErr[V1, V2]) Map7[V2, V3, V4, V5, V6, V7, V8, V9] {
+	return func(yield Yielder[V2]) {
+		s(func(v V1) bool {
+			mapped, err := Mapper(v)
+			PanicHaltIteration(err)
+			return yield(mapped)
+		})
+	}
+}
+
+// ParallelMap is identical to Map, except the Mapper is called concurrently on
+// a pool of workers goroutines. See [loz.Seq.ParallelMap].
+func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) ParallelMap(workers int, Mapper Mapper[V1, V2]) Map7[V2, V3, V4, V5, V6, V7, V8, V9] {
+	return ParallelMap(s, workers, Mapper)
+}
+
 func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) FilterMap(Mapper FilteringMapper[V1, V2]) Map7[V2, V3, V4, V5, V6, V7, V8, V9] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -798,6 +1637,38 @@ func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) FilterMap(Mapper FilteringMapp
 	}
 }
 
+// MapChunks transforms consecutive batches of elements using the provided
+// Mapper function. See [loz.Chunk].
+func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) MapChunks(n int, Mapper Mapper[[]V1, V2]) Map7[V2, V3, V4, V5, V6, V7, V8, V9] {
+	return func(yield Yielder[V2]) {
+		Chunk(Seq[V1](s), n)(func(chunk []V1) bool {
+			return yield(Mapper(chunk))
+		})
+	}
+}
+
+// MapWindows transforms sliding windows of elements using the provided Mapper
+// function. See [loz.Window].
+func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) MapWindows(size, step int, Mapper Mapper[[]V1, V2]) Map7[V2, V3, V4, V5, V6, V7, V8, V9] {
+	return func(yield Yielder[V2]) {
+		Window(Seq[V1](s), size, step)(func(window []V1) bool {
+			return yield(Mapper(window))
+		})
+	}
+}
+
+// Scan is identical to [loz.Seq.Scan] except that the type of the accumulator
+// can be different than the type of the elements in the sequence.
+func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) Scan(initial V2, combine Reducer[V1, V2]) Map7[V2, V3, V4, V5, V6, V7, V8, V9] {
+	return func(yield Yielder[V2]) {
+		acc := initial
+		s(func(v V1) bool {
+			acc = combine(acc, v)
+			return yield(acc)
+		})
+	}
+}
+
 func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) Expand(toElements Mapper[V1, Seq[V2]]) Map7[V2, V3, V4, V5, V6, V7, V8, V9] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -811,6 +1682,21 @@ func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) Expand(toElements Mapper[V1, S
 	}
 }
 
+// See [loz.Seq.Chain].
+func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) Chain(others ...Seq[V1]) Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9] {
+	return Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9](Seq[V1](s).Chain(others...))
+}
+
+// See [loz.Seq.Cycle].
+func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) Cycle() Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9] {
+	return Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9](Seq[V1](s).Cycle())
+}
+
+// See [loz.Seq.DedupFunc].
+func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) DedupFunc(equal func(a, b V1) bool) Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9] {
+	return Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9](Seq[V1](s).DedupFunc(equal))
+}
+
 // See [loz.Seq.Filter].
 func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) Filter(filter Yielder[V1]) Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9] {
 	return Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9](Seq[V1](s).Filter(filter))
@@ -826,6 +1712,11 @@ func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) SkipWhile(test Yielder[V1]) Ma
 	return Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9](Seq[V1](s).SkipWhile(test))
 }
 
+// See [loz.Seq.SortedFunc].
+func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) SortedFunc(compare func(a, b V1) int) Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9] {
+	return Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9](Seq[V1](s).SortedFunc(compare))
+}
+
 // See [loz.Seq.Take].
 func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) Take(toTake int) Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9] {
 	return Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9](Seq[V1](s).Take(toTake))
@@ -836,6 +1727,21 @@ func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) TakeWhile(test Yielder[V1]) Ma
 	return Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9](Seq[V1](s).TakeWhile(test))
 }
 
+// See [loz.Seq.ParallelFilter].
+func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) ParallelFilter(workers int, filter Yielder[V1]) Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9] {
+	return Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9](Seq[V1](s).ParallelFilter(workers, filter))
+}
+
+// See [loz.Seq.TopK].
+func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) TopK(n int, compare func(a, b V1) int) Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9] {
+	return Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9](Seq[V1](s).TopK(n, compare))
+}
+
+// See [loz.Seq.WithContext].
+func (s Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]) WithContext(ctx context.Context) Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9] {
+	return Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9](Seq[V1](s).WithContext(ctx))
+}
+
 type KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9 any] KVMap7[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8]
 
 // Map transforms the keys and values within the iterator using the provided Mapper function.
@@ -847,6 +1753,18 @@ func (s KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9]) MapErr(Mapper FilteringMapperErr2[K1, V1, K2, V2]) KVMap7[K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9] {
+	return func(yield Yielder2[K2, V2]) {
+		s(func(k K1, v V1) bool {
+			mk, mv, err := Mapper(k, v)
+			PanicHaltIteration(err)
+			return yield(mk, mv)
+		})
+	}
+}
+
 // Map transforms the keys and values within the iterator using the provided Mapper function.
 func (s KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9]) FilterMap(Mapper FilteringMapper2[K1, V1, K2, V2]) KVMap7[K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9] {
 	return func(yield Yielder2[K2, V2]) {
@@ -860,6 +1778,11 @@ func (s KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K
 	}
 }
 
+// See [KVSeq.Chain].
+func (s KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9]) Chain(others ...KVSeq[K1, V1]) KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9] {
+	return KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9](KVSeq[K1, V1](s).Chain(others...))
+}
+
 // See [KVSeq.Filter].
 func (s KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9]) Filter(filter Yielder2[K1, V1]) KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9] {
 	return KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9](KVSeq[K1, V1](s).Filter(filter))
@@ -875,6 +1798,16 @@ func (s KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K
 	return KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9](KVSeq[K1, V1](s).SkipWhile(test))
 }
 
+// See [KVSeq.SortedByKey].
+func (s KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9]) SortedByKey(compare func(a, b K1) int) KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9] {
+	return KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9](KVSeq[K1, V1](s).SortedByKey(compare))
+}
+
+// See [KVSeq.SortedByValue].
+func (s KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9]) SortedByValue(compare func(a, b V1) int) KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9] {
+	return KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9](KVSeq[K1, V1](s).SortedByValue(compare))
+}
+
 // See [KVSeq.Take].
 func (s KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9]) Take(toTake int) KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9] {
 	return KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9](KVSeq[K1, V1](s).Take(toTake))
@@ -885,6 +1818,11 @@ func (s KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K
 	return KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9](KVSeq[K1, V1](s).TakeWhile(test))
 }
 
+// See [KVSeq.WithContext].
+func (s KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9]) WithContext(ctx context.Context) KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9] {
+	return KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9](KVSeq[K1, V1](s).WithContext(ctx))
+}
+
 type Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10 any] Map8[V1, V2, V3, V4, V5, V6, V7, V8, V9]
 
 // Map transforms the elements within the iterator using the provided Mapper function.
@@ -896,6 +1834,24 @@ func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) Map(Mapper Mapper[V1, V2]
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) MapErr(Mapper FilteringMapperErr[V1, V2]) Map8[V2, V3, V4, V5, V6, V7, V8, V9, V10] {
+	return func(yield Yielder[V2]) {
+		s(func(v V1) bool {
+			mapped, err := Mapper(v)
+			PanicHaltIteration(err)
+			return yield(mapped)
+		})
+	}
+}
+
+// ParallelMap is identical to Map, except the Mapper is called concurrently on
+// a pool of workers goroutines. See [loz.Seq.ParallelMap].
+func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) ParallelMap(workers int, Mapper Mapper[V1, V2]) Map8[V2, V3, V4, V5, V6, V7, V8, V9, V10] {
+	return ParallelMap(s, workers, Mapper)
+}
+
 func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) FilterMap(Mapper FilteringMapper[V1, V2]) Map8[V2, V3, V4, V5, V6, V7, V8, V9, V10] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -908,6 +1864,38 @@ func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) FilterMap(Mapper Filterin
 	}
 }
 
+// MapChunks transforms consecutive batches of elements using the provided
+// Mapper function. See [loz.Chunk].
+func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) MapChunks(n int, Mapper Mapper[[]V1, V2]) Map8[V2, V3, V4, V5, V6, V7, V8, V9, V10] {
+	return func(yield Yielder[V2]) {
+		Chunk(Seq[V1](s), n)(func(chunk []V1) bool {
+			return yield(Mapper(chunk))
+		})
+	}
+}
+
+// MapWindows transforms sliding windows of elements using the provided Mapper
+// function. See [loz.Window].
+func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) MapWindows(size, step int, Mapper Mapper[[]V1, V2]) Map8[V2, V3, V4, V5, V6, V7, V8, V9, V10] {
+	return func(yield Yielder[V2]) {
+		Window(Seq[V1](s), size, step)(func(window []V1) bool {
+			return yield(Mapper(window))
+		})
+	}
+}
+
+// Scan is identical to [loz.Seq.Scan] except that the type of the accumulator
+// can be different than the type of the elements in the sequence.
+func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) Scan(initial V2, combine Reducer[V1, V2]) Map8[V2, V3, V4, V5, V6, V7, V8, V9, V10] {
+	return func(yield Yielder[V2]) {
+		acc := initial
+		s(func(v V1) bool {
+			acc = combine(acc, v)
+			return yield(acc)
+		})
+	}
+}
+
 func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) Expand(toElements Mapper[V1, Seq[V2]]) Map8[V2, V3, V4, V5, V6, V7, V8, V9, V10] {
 	return func(yield Yielder[V2]) {
 		s(func(v V1) bool {
@@ -921,6 +1909,21 @@ func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) Expand(toElements Mapper[
 	}
 }
 
+// See [loz.Seq.Chain].
+func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) Chain(others ...Seq[V1]) Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10] {
+	return Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10](Seq[V1](s).Chain(others...))
+}
+
+// See [loz.Seq.Cycle].
+func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) Cycle() Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10] {
+	return Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10](Seq[V1](s).Cycle())
+}
+
+// See [loz.Seq.DedupFunc].
+func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) DedupFunc(equal func(a, b V1) bool) Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10] {
+	return Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10](Seq[V1](s).DedupFunc(equal))
+}
+
 // See [loz.Seq.Filter].
 func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) Filter(filter Yielder[V1]) Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10] {
 	return Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10](Seq[V1](s).Filter(filter))
@@ -936,6 +1939,11 @@ func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) SkipWhile(test Yielder[V1
 	return Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10](Seq[V1](s).SkipWhile(test))
 }
 
+// See [loz.Seq.SortedFunc].
+func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) SortedFunc(compare func(a, b V1) int) Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10] {
+	return Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10](Seq[V1](s).SortedFunc(compare))
+}
+
 // See [loz.Seq.Take].
 func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) Take(toTake int) Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10] {
 	return Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10](Seq[V1](s).Take(toTake))
@@ -946,6 +1954,21 @@ func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) TakeWhile(test Yielder[V1
 	return Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10](Seq[V1](s).TakeWhile(test))
 }
 
+// See [loz.Seq.ParallelFilter].
+func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) ParallelFilter(workers int, filter Yielder[V1]) Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10] {
+	return Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10](Seq[V1](s).ParallelFilter(workers, filter))
+}
+
+// See [loz.Seq.TopK].
+func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) TopK(n int, compare func(a, b V1) int) Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10] {
+	return Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10](Seq[V1](s).TopK(n, compare))
+}
+
+// See [loz.Seq.WithContext].
+func (s Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10]) WithContext(ctx context.Context) Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10] {
+	return Map9[V1, V2, V3, V4, V5, V6, V7, V8, V9, V10](Seq[V1](s).WithContext(ctx))
+}
+
 type KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10 any] KVMap8[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9]
 
 // Map transforms the keys and values within the iterator using the provided Mapper function.
@@ -957,6 +1980,18 @@ func (s KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K
 	}
 }
 
+// MapErr is identical to Map, except the Mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration].
+func (s KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10]) MapErr(Mapper FilteringMapperErr2[K1, V1, K2, V2]) KVMap8[K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10] {
+	return func(yield Yielder2[K2, V2]) {
+		s(func(k K1, v V1) bool {
+			mk, mv, err := Mapper(k, v)
+			PanicHaltIteration(err)
+			return yield(mk, mv)
+		})
+	}
+}
+
 // Map transforms the keys and values within the iterator using the provided Mapper function.
 func (s KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10]) FilterMap(Mapper FilteringMapper2[K1, V1, K2, V2]) KVMap8[K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10] {
 	return func(yield Yielder2[K2, V2]) {
@@ -970,6 +2005,11 @@ func (s KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K
 	}
 }
 
+// See [KVSeq.Chain].
+func (s KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10]) Chain(others ...KVSeq[K1, V1]) KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10] {
+	return KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10](KVSeq[K1, V1](s).Chain(others...))
+}
+
 // See [KVSeq.Filter].
 func (s KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10]) Filter(filter Yielder2[K1, V1]) KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10] {
 	return KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10](KVSeq[K1, V1](s).Filter(filter))
@@ -985,6 +2025,16 @@ func (s KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K
 	return KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10](KVSeq[K1, V1](s).SkipWhile(test))
 }
 
+// See [KVSeq.SortedByKey].
+func (s KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10]) SortedByKey(compare func(a, b K1) int) KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10] {
+	return KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10](KVSeq[K1, V1](s).SortedByKey(compare))
+}
+
+// See [KVSeq.SortedByValue].
+func (s KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10]) SortedByValue(compare func(a, b V1) int) KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10] {
+	return KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10](KVSeq[K1, V1](s).SortedByValue(compare))
+}
+
 // See [KVSeq.Take].
 func (s KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10]) Take(toTake int) KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10] {
 	return KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10](KVSeq[K1, V1](s).Take(toTake))
@@ -994,3 +2044,8 @@ func (s KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K
 func (s KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10]) TakeWhile(test Yielder2[K1, V1]) KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10] {
 	return KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10](KVSeq[K1, V1](s).TakeWhile(test))
 }
+
+// See [KVSeq.WithContext].
+func (s KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10]) WithContext(ctx context.Context) KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10] {
+	return KVMap9[K1, V1, K2, V2, K3, V3, K4, V4, K5, V5, K6, V6, K7, V7, K8, V8, K9, V9, K10, V10](KVSeq[K1, V1](s).WithContext(ctx))
+}
@@ -1,6 +1,7 @@
 package mapping_test
 
 import (
+	"errors"
 	"fmt"
 	"maps"
 	"reflect"
@@ -30,12 +31,24 @@ func TestMapHasAllSeqMethods(t *testing.T) {
 		"TryLast",
 		"ForEach",
 		"TryForEach",
+		"ParallelForEach",
+		"TryParallelForEach",
 		"CollectSlice",
 		"TryCollectSlice",
 		"AppendSlice",
 		"Reduce",
 		"TryReduce",
+		"MinBy",
+		"TryMinBy",
+		"MaxBy",
+		"TryMaxBy",
+		"Count",
+		"TryCount",
 		"Indexed",
+		"Partition",
+		"TryPartition",
+		"ToChan",
+		"EncodeTo",
 	}
 	for i := range seqType.NumMethod() {
 		seqMethod := seqType.Method(i)
@@ -82,3 +95,39 @@ func TestKVMap1(t *testing.T) {
 	assert.ElementsMatch(t, iterator.Keys().CollectSlice(), []string{"1", "2", "3", "4", "5"})
 	assert.ElementsMatch(t, iterator.Values().CollectSlice(), []byte{'o', 't', 't', 'f', 'f'})
 }
+
+func TestKVMapErr(t *testing.T) {
+	haltingErr := errors.New("Testing error")
+	mapper := lom.KVMap1[int, string, string, int](loz.IterSlice([]string{"a", "b", "c"}).Indexed())
+	mapped := mapper.MapErr(func(i int, s string) (string, int, error) {
+		if i == 1 {
+			return "", 0, haltingErr
+		}
+		return s, i, nil
+	})
+	var keys []string
+	err := mapped.TryForEach(func(s string, _ int) {
+		keys = append(keys, s)
+	})
+	assert.Equal(t, []string{"a"}, keys)
+	assert.Equal(t, haltingErr, err)
+}
+
+func TestMapChunksAndWindows(t *testing.T) {
+	sum := func(nums []int) int {
+		return loz.IterSlice(nums).Fold(0, func(a, b int) int { return a + b })
+	}
+	nums := loz.Generate(5, func(idx int) int { return idx + 1 })
+	sums := lom.Map2[int, int, string](nums).
+		MapChunks(2, sum).
+		Map(func(n int) string { return fmt.Sprint(n) }).
+		CollectSlice()
+	assert.Equal(t, []string{"3", "7", "5"}, sums)
+
+	averages := lom.Map1[int, float64](nums).
+		MapWindows(3, 1, func(window []int) float64 {
+			return float64(sum(window)) / float64(len(window))
+		}).
+		CollectSlice()
+	assert.Equal(t, []float64{2, 3, 4}, averages)
+}
@@ -0,0 +1,84 @@
+package loz
+
+import (
+	"iter"
+
+	. "github.com/jmatth/loz/internal"
+)
+
+// MergeSorted lazily merges several sequences that are each already sorted
+// according to compare into a single sorted sequence. Only the next element of
+// each input is held in memory at a time. When elements from different inputs
+// compare equal, the one from the input listed first is yielded first.
+func MergeSorted[V any](compare func(a, b V) int, seqs ...Seq[V]) Seq[V] {
+	type cursor struct {
+		val  V
+		idx  int
+		next func() (V, bool)
+	}
+	return func(yield Yielder[V]) {
+		h := NewHeap(func(a, b *cursor) bool {
+			if c := compare(a.val, b.val); c != 0 {
+				return c < 0
+			}
+			return a.idx < b.idx
+		})
+		for i, s := range seqs {
+			next, stop := iter.Pull(iter.Seq[V](s))
+			defer stop()
+			if v, ok := next(); ok {
+				h.Push(&cursor{val: v, idx: i, next: next})
+			}
+		}
+		for h.Len() > 0 {
+			c := h.Peek()
+			if !yield(c.val) {
+				return
+			}
+			if v, ok := c.next(); ok {
+				c.val = v
+				h.Fix(0)
+			} else {
+				h.Pop()
+			}
+		}
+	}
+}
+
+// MergeSortedKV is identical to [MergeSorted], except it merges sequences of
+// key/value pairs that are each already sorted by their keys.
+func MergeSortedKV[K, V any](compare func(a, b K) int, seqs ...KVSeq[K, V]) KVSeq[K, V] {
+	type cursor struct {
+		key  K
+		val  V
+		idx  int
+		next func() (K, V, bool)
+	}
+	return func(yield Yielder2[K, V]) {
+		h := NewHeap(func(a, b *cursor) bool {
+			if c := compare(a.key, b.key); c != 0 {
+				return c < 0
+			}
+			return a.idx < b.idx
+		})
+		for i, s := range seqs {
+			next, stop := iter.Pull2(iter.Seq2[K, V](s))
+			defer stop()
+			if k, v, ok := next(); ok {
+				h.Push(&cursor{key: k, val: v, idx: i, next: next})
+			}
+		}
+		for h.Len() > 0 {
+			c := h.Peek()
+			if !yield(c.key, c.val) {
+				return
+			}
+			if k, v, ok := c.next(); ok {
+				c.key, c.val = k, v
+				h.Fix(0)
+			} else {
+				h.Pop()
+			}
+		}
+	}
+}
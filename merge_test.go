@@ -0,0 +1,64 @@
+package loz_test
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleMergeSorted() {
+	merged := loz.MergeSorted(cmp.Compare[int],
+		loz.IterSlice([]int{1, 4, 7}),
+		loz.IterSlice([]int{2, 5, 8}),
+		loz.IterSlice([]int{3, 6, 9, 10}),
+	).CollectSlice()
+	fmt.Print(merged)
+	// Output: [1 2 3 4 5 6 7 8 9 10]
+}
+
+func ExampleMergeSortedKV() {
+	loz.MergeSortedKV(cmp.Compare[int],
+		iterKVPairs[int, string](1, "shard a", 3, "shard a"),
+		iterKVPairs[int, string](1, "shard b", 2, "shard b"),
+	).ForEach(func(ts int, shard string) {
+		fmt.Printf("%v: %v\n", ts, shard)
+	})
+	// Output: 1: shard a
+	// 1: shard b
+	// 2: shard b
+	// 3: shard a
+}
+
+func TestMergeSortedIsLazy(t *testing.T) {
+	pulled := 0
+	counting := func(start int) loz.Seq[int] {
+		return loz.Generate(1_000, func(idx int) int {
+			pulled++
+			return start + idx*2
+		})
+	}
+	merged := loz.MergeSorted(cmp.Compare[int], counting(0), counting(1)).
+		Take(4).
+		CollectSlice()
+	assert.Equal(t, []int{0, 1, 2, 3}, merged)
+	assert.Less(t, pulled, 10)
+	assert.Empty(t, loz.MergeSorted[int](cmp.Compare[int]).CollectSlice())
+}
+
+func TestMergeSortedHalt(t *testing.T) {
+	haltingErr := errors.New("Testing error")
+	halting := loz.Generate(5, func(idx int) int {
+		if idx == 2 {
+			loz.PanicHaltIteration(haltingErr)
+		}
+		return idx
+	})
+	result, err := loz.MergeSorted(cmp.Compare[int], loz.IterSlice([]int{1, 2}), halting).
+		TryCollectSlice()
+	assert.Nil(t, result)
+	assert.Equal(t, haltingErr, err)
+}
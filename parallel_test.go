@@ -0,0 +1,168 @@
+package loz_test
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jmatth/loz"
+	lom "github.com/jmatth/loz/mapping"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleSeq_ParallelMap() {
+	squares := loz.Generate(5, func(idx int) int { return idx + 1 }).
+		ParallelMap(3, func(n int) int {
+			time.Sleep(time.Duration(5-n) * time.Millisecond)
+			return n * n
+		}).
+		CollectSlice()
+	fmt.Print(squares)
+	// Output: [1 4 9 16 25]
+}
+
+func TestParallelMapPreservesOrder(t *testing.T) {
+	expected := loz.Generate(100, func(idx int) string { return fmt.Sprint(idx) }).CollectSlice()
+	result := lom.Map1[int, string](loz.Generate(100, func(idx int) int { return idx })).
+		ParallelMap(8, func(n int) string {
+			time.Sleep(time.Duration(n%7) * time.Millisecond)
+			return fmt.Sprint(n)
+		}).
+		CollectSlice()
+	assert.Equal(t, expected, result)
+}
+
+func TestParallelMapEarlyTermination(t *testing.T) {
+	before := runtime.NumGoroutine()
+	var calls atomic.Int32
+	result := loz.Generate(1_000, func(idx int) int { return idx }).
+		ParallelMap(4, func(n int) int {
+			calls.Add(1)
+			return n
+		}).
+		Take(3).
+		CollectSlice()
+	assert.Equal(t, []int{0, 1, 2}, result)
+	assert.Less(t, calls.Load(), int32(20))
+	for range 100 {
+		if runtime.NumGoroutine() <= before {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), before)
+}
+
+func TestParallelMapHalt(t *testing.T) {
+	haltingErr := errors.New("Testing error")
+	result, err := loz.Generate(50, func(idx int) int { return idx }).
+		ParallelMap(4, func(n int) int {
+			if n == 10 {
+				loz.PanicHaltIteration(haltingErr)
+			}
+			return n
+		}).
+		TryCollectSlice()
+	assert.Nil(t, result)
+	assert.Equal(t, haltingErr, err)
+
+	result, err = loz.Generate(50, func(idx int) int {
+		if idx == 10 {
+			loz.PanicHaltIteration(haltingErr)
+		}
+		return idx
+	}).
+		ParallelMap(4, func(n int) int { return n }).
+		TryCollectSlice()
+	assert.Nil(t, result)
+	assert.Equal(t, haltingErr, err)
+}
+
+func TestParallelMapRepanics(t *testing.T) {
+	assert.PanicsWithValue(t, "boom", func() {
+		loz.Generate(5, func(idx int) int { return idx }).
+			ParallelMap(2, func(n int) int {
+				if n == 3 {
+					panic("boom")
+				}
+				return n
+			}).
+			ForEach(func(int) {})
+	})
+}
+
+func ExampleSeq_ParallelFilter() {
+	evens := loz.Generate(10, func(idx int) int { return idx }).
+		ParallelFilter(4, func(n int) bool { return n%2 == 0 }).
+		CollectSlice()
+	slices.Sort(evens)
+	fmt.Print(evens)
+	// Output: [0 2 4 6 8]
+}
+
+func ExampleSeq_TryParallelForEach() {
+	var sum atomic.Int64
+	err := loz.Generate(100, func(idx int) int { return idx + 1 }).
+		TryParallelForEach(8, func(n int) {
+			sum.Add(int64(n))
+		})
+	fmt.Printf("%v; %v", sum.Load(), err)
+	// Output: 5050; <nil>
+}
+
+func TestParallelFilterEarlyTermination(t *testing.T) {
+	var calls atomic.Int32
+	result := loz.Generate(1_000, func(idx int) int { return idx }).
+		ParallelFilter(4, func(n int) bool {
+			calls.Add(1)
+			return true
+		}).
+		Take(3).
+		CollectSlice()
+	assert.Len(t, result, 3)
+	assert.Less(t, calls.Load(), int32(20))
+}
+
+func TestTryParallelForEachCancels(t *testing.T) {
+	haltingErr := errors.New("Testing error")
+	var calls atomic.Int32
+	err := loz.Generate(1_000, func(idx int) int { return idx }).
+		TryParallelForEach(4, func(n int) {
+			calls.Add(1)
+			if n == 5 {
+				loz.PanicHaltIteration(haltingErr)
+			}
+		})
+	assert.Equal(t, haltingErr, err)
+	assert.Less(t, calls.Load(), int32(100))
+}
+
+func TestTryParallelForEachJoinsErrors(t *testing.T) {
+	errs := []error{errors.New("first"), errors.New("second")}
+	var started sync.WaitGroup
+	started.Add(len(errs))
+	err := loz.IterSlice(errs).
+		TryParallelForEach(len(errs), func(err error) {
+			started.Done()
+			started.Wait()
+			loz.PanicHaltIteration(err)
+		})
+	assert.ErrorIs(t, err, errs[0])
+	assert.ErrorIs(t, err, errs[1])
+}
+
+func TestParallelForEachRepanics(t *testing.T) {
+	assert.PanicsWithValue(t, "boom", func() {
+		loz.Generate(5, func(idx int) int { return idx }).
+			TryParallelForEach(2, func(n int) {
+				if n == 3 {
+					panic("boom")
+				}
+			})
+	})
+}
@@ -0,0 +1,91 @@
+package loz
+
+import . "github.com/jmatth/loz/internal"
+
+// Range creates a Seq over the integers from start up to but not including
+// end, incrementing by step. A negative step counts down from start to end
+// instead. If start is already at or past end in the direction of step the
+// result is empty. Range panics if step is 0.
+func Range[V Integer](start, end, step V) Seq[V] {
+	if step == 0 {
+		panic("loz.Range: step cannot be 0")
+	}
+	return func(yield Yielder[V]) {
+		if step > 0 {
+			for v := start; v < end; {
+				if !yield(v) {
+					return
+				}
+				next := v + step
+				if next < v {
+					return
+				}
+				v = next
+			}
+			return
+		}
+		for v := start; v > end; {
+			if !yield(v) {
+				return
+			}
+			next := v + step
+			if next > v {
+				return
+			}
+			v = next
+		}
+	}
+}
+
+// RangeInclusive is identical to [Range], except end is also yielded if it
+// can be reached from start in increments of step.
+func RangeInclusive[V Integer](start, end, step V) Seq[V] {
+	if step == 0 {
+		panic("loz.RangeInclusive: step cannot be 0")
+	}
+	return func(yield Yielder[V]) {
+		if step > 0 {
+			for v := start; v <= end; {
+				if !yield(v) {
+					return
+				}
+				next := v + step
+				if next < v {
+					return
+				}
+				v = next
+			}
+			return
+		}
+		for v := start; v >= end; {
+			if !yield(v) {
+				return
+			}
+			next := v + step
+			if next > v {
+				return
+			}
+			v = next
+		}
+	}
+}
+
+// Linspace creates a Seq of n evenly spaced values from start to end, both
+// inclusive. If n is 1 only start is yielded, and if n < 1 the result is empty.
+func Linspace[V Float](start, end V, n int) Seq[V] {
+	return func(yield Yielder[V]) {
+		if n == 1 {
+			yield(start)
+			return
+		}
+		for i := range n {
+			v := start + (end-start)*V(i)/V(n-1)
+			if i == n-1 {
+				v = end
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package loz_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleRange() {
+	fmt.Println(loz.Range(0, 10, 3).CollectSlice())
+	fmt.Println(loz.Range(10, 0, -3).CollectSlice())
+	// Output: [0 3 6 9]
+	// [10 7 4 1]
+}
+
+func ExampleRangeInclusive() {
+	fmt.Println(loz.RangeInclusive(0, 9, 3).CollectSlice())
+	fmt.Println(loz.RangeInclusive(9, 0, -3).CollectSlice())
+	// Output: [0 3 6 9]
+	// [9 6 3 0]
+}
+
+func ExampleLinspace() {
+	fmt.Print(loz.Linspace(0.0, 1.0, 5).CollectSlice())
+	// Output: [0 0.25 0.5 0.75 1]
+}
+
+func TestRangeEdges(t *testing.T) {
+	assert.Empty(t, loz.Range(5, 5, 1).CollectSlice())
+	assert.Empty(t, loz.Range(5, 0, 1).CollectSlice())
+	assert.Empty(t, loz.Range(0, 5, -1).CollectSlice())
+	assert.Equal(t, []int{5}, loz.RangeInclusive(5, 5, 1).CollectSlice())
+	assert.Equal(t, []int{5}, loz.RangeInclusive(5, 5, -1).CollectSlice())
+	assert.Equal(t, []int{0, 1}, loz.Range(0, 100, 1).Take(2).CollectSlice())
+	assert.Panics(t, func() { loz.Range(0, 5, 0) })
+	assert.Panics(t, func() { loz.RangeInclusive(0, 5, 0) })
+}
+
+func TestRangeDoesNotOverflow(t *testing.T) {
+	assert.Equal(t, []uint8{250, 253}, loz.Range[uint8](250, 255, 3).CollectSlice())
+	assert.Equal(t, []uint8{251, 253, 255}, loz.RangeInclusive[uint8](251, 255, 2).CollectSlice())
+	assert.Equal(t, []int8{-124, -127}, loz.Range[int8](-124, math.MinInt8, -3).CollectSlice())
+	assert.Equal(t, []int8{-126, -128}, loz.RangeInclusive[int8](-126, math.MinInt8, -2).CollectSlice())
+	assert.Equal(t, []uint8{0, 255}, loz.RangeInclusive[uint8](0, 255, 255).CollectSlice())
+	assert.Equal(t, []int8{127}, loz.RangeInclusive[int8](127, math.MaxInt8, 1).CollectSlice())
+}
+
+func TestRangeWideSpans(t *testing.T) {
+	assert.Equal(t, []int8{-100, -50, 0, 50}, loz.Range[int8](-100, 100, 50).CollectSlice())
+	assert.Equal(t, []int8{100, 50, 0, -50}, loz.Range[int8](100, -100, -50).CollectSlice())
+	assert.Equal(t, []int8{-100, -50, 0, 50, 100}, loz.RangeInclusive[int8](-100, 100, 50).CollectSlice())
+	assert.Equal(t, []int8{100, 50, 0, -50, -100}, loz.RangeInclusive[int8](100, -100, -50).CollectSlice())
+	assert.Equal(t, []int8{-128, -1, 126}, loz.Range[int8](math.MinInt8, math.MaxInt8, 127).CollectSlice())
+	assert.Equal(t, []int8{127, 0, -127}, loz.RangeInclusive[int8](math.MaxInt8, math.MinInt8, -127).CollectSlice())
+	assert.Equal(t, []int32{-2e9, -1e9, 0, 1e9}, loz.Range[int32](-2e9, 2e9, 1e9).CollectSlice())
+	assert.Equal(t, []int32{2e9, 1e9, 0, -1e9, -2e9}, loz.RangeInclusive[int32](2e9, -2e9, -1e9).CollectSlice())
+	assert.Equal(t, []int{math.MinInt64 + 1, 0}, loz.Range(math.MinInt64+1, math.MaxInt64, math.MaxInt64).CollectSlice())
+	assert.Equal(t, []int{math.MinInt64 + 1, 0, math.MaxInt64},
+		loz.RangeInclusive(math.MinInt64+1, math.MaxInt64, math.MaxInt64).CollectSlice())
+}
+
+func TestLinspaceEdges(t *testing.T) {
+	assert.Empty(t, loz.Linspace(0.0, 1.0, 0).CollectSlice())
+	assert.Equal(t, []float32{2}, loz.Linspace[float32](2, 5, 1).CollectSlice())
+	assert.Equal(t, []float64{1, 0.5, 0}, loz.Linspace(1.0, 0, 3).CollectSlice())
+}
@@ -1,6 +1,7 @@
 package loz
 
 import (
+	"context"
 	"iter"
 	"slices"
 
@@ -23,6 +24,42 @@ func Generate[V any](count int, generator func(idx int) V) Seq[V] {
 	}
 }
 
+// Repeat creates an infinite Seq that yields v forever. Combine it with a
+// method such as [Seq.Take] or [Seq.TakeWhile] to end the iteration.
+func Repeat[V any](v V) Seq[V] {
+	return func(yield Yielder[V]) {
+		for yield(v) {
+		}
+	}
+}
+
+// Iterate creates an infinite Seq that yields seed, then next(seed), then
+// next(next(seed)), and so on. Combine it with a method such as [Seq.Take] or
+// [Seq.TakeWhile] to end the iteration.
+func Iterate[V any](seed V, next Mapper[V, V]) Seq[V] {
+	return func(yield Yielder[V]) {
+		for v := seed; yield(v); v = next(v) {
+		}
+	}
+}
+
+// Unfold creates a Seq by repeatedly calling generate with a state value,
+// starting with seed. Each call returns an element to yield, the state for the
+// next call, and whether to continue. The iteration ends the first time
+// generate returns false, without yielding the element from that call.
+func Unfold[V, S any](seed S, generate func(state S) (V, S, bool)) Seq[V] {
+	return func(yield Yielder[V]) {
+		state := seed
+		for {
+			v, next, ok := generate(state)
+			if !ok || !yield(v) {
+				return
+			}
+			state = next
+		}
+	}
+}
+
 // IterSlice creates a Seq over the contents of a slice.
 func IterSlice[V any](slice []V) Seq[V] {
 	return Seq[V](slices.Values(slice))
@@ -62,6 +99,29 @@ func (s Seq[V]) TryForEach(process Processor[V]) (err error) {
 	return nil
 }
 
+// ParallelForEach is identical to [Seq.ForEach], except process is called
+// concurrently on a pool of workers goroutines with no guarantee on the order
+// of the calls. If process panics no further elements are started, and once
+// the calls already running have finished the panic is re-raised. A workers
+// value < 1 is treated as 1.
+func (s Seq[V]) ParallelForEach(workers int, process Processor[V]) {
+	for range ParallelFilterMap(s, workers, func(v V) (V, bool) {
+		process(v)
+		return v, false
+	}) {
+	}
+}
+
+// TryParallelForEach is identical to [Seq.ParallelForEach], except it will
+// recover any panic caused by [PanicHaltIteration] and return the wrapped
+// error. If more than one call halts the iteration before the others are
+// cancelled, their errors are combined with [errors.Join].
+func (s Seq[V]) TryParallelForEach(workers int, process Processor[V]) (err error) {
+	defer RecoverHaltIteration(&err)
+	s.ParallelForEach(workers, process)
+	return nil
+}
+
 // Map transforms the elements within the iterator using the provided mapper
 // function. Due to limitations of the Go type system, the mapped value must be
 // the same type as the input. To perform mapping operations that change type,
@@ -74,6 +134,16 @@ func (s Seq[V]) Map(mapper Mapper[V, V]) Seq[V] {
 	}
 }
 
+// ParallelMap is identical to [Seq.Map], except the mapper is called
+// concurrently on a pool of workers goroutines. Elements are still yielded in
+// the same order as the input, and the goroutines are shut down once the
+// iteration completes or is stopped early. A panic in the mapper, including one
+// caused by [PanicHaltIteration], is re-raised on the consuming goroutine. A
+// workers value < 1 is treated as 1.
+func (s Seq[V]) ParallelMap(workers int, mapper Mapper[V, V]) Seq[V] {
+	return ParallelMap(s, workers, mapper)
+}
+
 // FilterMap is a combination of [Seq.Filter] and [Seq.Map]. If the provided
 // mapper function returns false, then the current element of the iteration
 // will be skipped. If true is returned, then the mapped value is passed to the
@@ -90,6 +160,20 @@ func (s Seq[V]) FilterMap(mapper FilteringMapper[V, V]) Seq[V] {
 	}
 }
 
+// MapErr is identical to [Seq.Map], except the mapper may return an error. The
+// first non-nil error halts the iteration as if passed to [PanicHaltIteration],
+// so it should be consumed with a terminal method prefixed with "Try", such as
+// [Seq.TryCollectSlice], which will return the error.
+func (s Seq[V]) MapErr(mapper FilteringMapperErr[V, V]) Seq[V] {
+	return func(yield Yielder[V]) {
+		s(func(v V) bool {
+			mapped, err := mapper(v)
+			PanicHaltIteration(err)
+			return yield(mapped)
+		})
+	}
+}
+
 // Reduce reduces the iterator to a single value by iteratively combining its
 // elements using the provided function. If the iterator is empty a zero value
 // will be returned along with an error.
@@ -136,6 +220,20 @@ func (s Seq[V]) TryFold(initial V, combine Reducer[V, V]) (result V, err error)
 	return s.Fold(initial, combine), nil
 }
 
+// Scan is a lazy version of [Seq.Fold] that yields each intermediate result.
+// The accumulator starts as initial and is combined with each element in turn,
+// and the updated accumulator is yielded after every element. The initial
+// value itself is not yielded, so an empty iterator yields nothing.
+func (s Seq[V]) Scan(initial V, combine Reducer[V, V]) Seq[V] {
+	return func(yield Yielder[V]) {
+		acc := initial
+		s(func(v V) bool {
+			acc = combine(acc, v)
+			return yield(acc)
+		})
+	}
+}
+
 // First consumes the iterator and returns its first element. If the iterator
 // is empty a zero value will be returned with an error.
 func (s Seq[V]) First() (V, error) {
@@ -259,6 +357,55 @@ func (s Seq[V]) Filter(filter Yielder[V]) Seq[V] {
 	}
 }
 
+// Partition consumes the iterator and splits its elements into those for
+// which test returns true and those for which it returns false, calling test
+// exactly once per element.
+func (s Seq[V]) Partition(test Yielder[V]) (matched, rest []V) {
+	s(func(v V) bool {
+		if test(v) {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+		return true
+	})
+	return matched, rest
+}
+
+// TryPartition is identical to [Seq.Partition], except it will recover any
+// panic caused by [PanicHaltIteration] and return the wrapped error.
+func (s Seq[V]) TryPartition(test Yielder[V]) (matched, rest []V, err error) {
+	defer RecoverHaltIteration(&err)
+	matched, rest = s.Partition(test)
+	return matched, rest, nil
+}
+
+// ParallelFilter is identical to [Seq.Filter], except filter is called
+// concurrently on a pool of workers goroutines. Elements are yielded in the
+// order their calls to filter complete rather than the order of the input.
+// Panics are handled the same as [Seq.ParallelForEach]. A workers value < 1 is
+// treated as 1.
+func (s Seq[V]) ParallelFilter(workers int, filter Yielder[V]) Seq[V] {
+	return ParallelFilterMap(s, workers, func(v V) (V, bool) {
+		return v, filter(v)
+	})
+}
+
+// WithContext restricts the iterator to the elements yielded before ctx is
+// done. Once ctx is done the iteration is halted as if ctx.Err() was passed to
+// [PanicHaltIteration], so the result should be consumed with a terminal
+// method prefixed with "Try", such as [Seq.TryCollectSlice], which will return
+// the context's error.
+func (s Seq[V]) WithContext(ctx context.Context) Seq[V] {
+	return func(yield Yielder[V]) {
+		PanicHaltIteration(ctx.Err())
+		s(func(v V) bool {
+			PanicHaltIteration(ctx.Err())
+			return yield(v)
+		})
+	}
+}
+
 // Skip skips the first toSkip elements of the iterator. If toSkip is greater
 // than or equal to the number of elements in the iterator the result will be
 // an empty iterator.
@@ -338,3 +485,29 @@ func (s Seq[V]) Expand(toElements Mapper[V, Seq[V]]) Seq[V] {
 		})
 	}
 }
+
+// Cycle repeats the elements of the iterator forever. The elements are
+// buffered during the first pass and replayed from the buffer afterwards, so
+// the underlying iterator is only consumed once, which allows single-use
+// sources to be cycled. If the iterator is empty the result is empty as well.
+func (s Seq[V]) Cycle() Seq[V] {
+	return func(yield Yielder[V]) {
+		var buf []V
+		stopped := false
+		s(func(v V) bool {
+			buf = append(buf, v)
+			stopped = !yield(v)
+			return !stopped
+		})
+		if stopped || len(buf) == 0 {
+			return
+		}
+		for {
+			for _, v := range buf {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package loz_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -37,6 +38,29 @@ func ExampleSeq_Filter() {
 	// Output: [false false]
 }
 
+func ExampleSeq_Partition() {
+	evens, odds := loz.Generate(7, func(idx int) int { return idx }).
+		Partition(func(n int) bool { return n%2 == 0 })
+	fmt.Printf("%v %v", evens, odds)
+	// Output: [0 2 4 6] [1 3 5]
+}
+
+func TestPartitionSingleUse(t *testing.T) {
+	ch := make(chan int, 4)
+	for i := range 4 {
+		ch <- i
+	}
+	close(ch)
+	calls := 0
+	small, big := loz.FromChan(ch).Partition(func(n int) bool {
+		calls++
+		return n < 2
+	})
+	assert.Equal(t, []int{0, 1}, small)
+	assert.Equal(t, []int{2, 3}, big)
+	assert.Equal(t, 4, calls)
+}
+
 func ExampleSeq_Skip() {
 	nums := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
 	skipped := loz.IterSlice(nums).Skip(3).CollectSlice()
@@ -148,6 +172,17 @@ func ExampleSeq_Fold() {
 	// Output: 100, 16
 }
 
+func ExampleSeq_Scan() {
+	prefixSums := loz.IterSlice([]int{3, 1, 4, 1, 5}).
+		Scan(0, func(acc, n int) int { return acc + n }).
+		CollectSlice()
+	runningMax := loz.IterSlice([]int{3, 1, 4, 1, 5}).
+		Scan(0, func(acc, n int) int { return max(acc, n) }).
+		CollectSlice()
+	fmt.Printf("%v %v", prefixSums, runningMax)
+	// Output: [3 4 8 9 14] [3 3 4 4 5]
+}
+
 func ExampleSeq_Reduce() {
 	mult := func(a, b int) int {
 		return a * b
@@ -167,6 +202,44 @@ func ExampleSeq_ForEach() {
 	// Output: (1)(2)(3)
 }
 
+func ExampleRepeat() {
+	fmt.Print(loz.Repeat("ha").Take(3).CollectSlice())
+	// Output: [ha ha ha]
+}
+
+func ExampleIterate() {
+	backoff := loz.Iterate(100, func(ms int) int { return ms * 2 }).
+		TakeWhile(func(ms int) bool { return ms < 2_000 }).
+		CollectSlice()
+	fmt.Print(backoff)
+	// Output: [100 200 400 800 1600]
+}
+
+func ExampleUnfold() {
+	pages := map[string]string{"": "page2", "page2": "page3", "page3": ""}
+	cursors := loz.Unfold("", func(cursor string) (string, string, bool) {
+		next, ok := pages[cursor]
+		return next, next, ok && next != ""
+	}).CollectSlice()
+	fmt.Print(cursors)
+	// Output: [page2 page3]
+}
+
+func ExampleSeq_Cycle() {
+	fmt.Print(loz.IterSlice([]int{1, 2, 3}).Cycle().Take(7).CollectSlice())
+	// Output: [1 2 3 1 2 3 1]
+}
+
+func TestCycleSingleUse(t *testing.T) {
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	close(ch)
+	assert.Equal(t, []int{1, 2, 1, 2, 1}, loz.FromChan(ch).Cycle().Take(5).CollectSlice())
+	assert.Empty(t, loz.IterSlice([]int{}).Cycle().CollectSlice())
+	assert.Equal(t, []int{1}, loz.IterSlice([]int{1, 2}).Cycle().Take(1).CollectSlice())
+}
+
 func TestSkipAll(t *testing.T) {
 	nums := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
 	skipped := loz.IterSlice(nums).Skip(100).CollectSlice()
@@ -245,6 +318,48 @@ func ExampleSeq_FilterMap() {
 	// Output: [1 4 5]
 }
 
+func ExampleSeq_MapErr() {
+	halved, err := loz.IterSlice([]int{2, 4, 5, 6}).
+		MapErr(func(n int) (int, error) {
+			if n%2 != 0 {
+				return 0, fmt.Errorf("%d is odd", n)
+			}
+			return n / 2, nil
+		}).
+		TryCollectSlice()
+	fmt.Printf("%v; %v", halved, err)
+	// Output: []; 5 is odd
+}
+
+func ExampleSeq_WithContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result, err := loz.Generate(10, func(idx int) int {
+		if idx == 3 {
+			cancel()
+		}
+		return idx
+	}).
+		WithContext(ctx).
+		TryCollectSlice()
+	fmt.Printf("%v; %v", result, err)
+	// Output: []; context canceled
+}
+
+func TestWithContextAlreadyDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	generated := 0
+	err := loz.Generate(5, func(idx int) int {
+		generated++
+		return idx
+	}).
+		WithContext(ctx).
+		TryForEach(func(int) {})
+	assert.Equal(t, context.Canceled, err)
+	assert.Zero(t, generated)
+}
+
 func TestSeqTryMethods(t *testing.T) {
 	seq := loz.Generate(5, func(idx int) int {
 		return idx
@@ -314,6 +429,15 @@ func TestSeqTryMethods(t *testing.T) {
 				return err
 			},
 		},
+		{
+			"TryPartition",
+			func(s loz.Seq[int]) error {
+				_, _, err := s.TryPartition(func(i int) bool {
+					return i%2 == 0
+				})
+				return err
+			},
+		},
 		{
 			"TryEvery",
 			func(s loz.Seq[int]) error {
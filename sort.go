@@ -0,0 +1,104 @@
+package loz
+
+import (
+	"cmp"
+	"slices"
+
+	. "github.com/jmatth/loz/internal"
+)
+
+// SortedFunc sorts the elements of the iterator using compare, which should
+// return a negative number when a < b, a positive number when a > b, and zero
+// when a == b, such as [cmp.Compare]. The sort is stable. The input is
+// buffered in full once the result is iterated, and the sorted elements are
+// then yielded in order, so sorting can be used in the middle of a pipeline.
+func (s Seq[V]) SortedFunc(compare func(a, b V) int) Seq[V] {
+	return func(yield Yielder[V]) {
+		sorted := s.CollectSlice()
+		slices.SortStableFunc(sorted, compare)
+		for _, v := range sorted {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted sorts the elements of the iterator in ascending order. See
+// [Seq.SortedFunc].
+func Sorted[V cmp.Ordered](s Seq[V]) Seq[V] {
+	return s.SortedFunc(cmp.Compare[V])
+}
+
+// SortedByKey sorts the key/value pairs of the iterator by their keys using
+// compare. See [Seq.SortedFunc].
+func (s KVSeq[K, V]) SortedByKey(compare func(a, b K) int) KVSeq[K, V] {
+	return s.sortedFunc(func(a, b Pair[K, V]) int {
+		return compare(a.First, b.First)
+	})
+}
+
+// SortedByValue sorts the key/value pairs of the iterator by their values
+// using compare. See [Seq.SortedFunc].
+func (s KVSeq[K, V]) SortedByValue(compare func(a, b V) int) KVSeq[K, V] {
+	return s.sortedFunc(func(a, b Pair[K, V]) int {
+		return compare(a.Second, b.Second)
+	})
+}
+
+func (s KVSeq[K, V]) sortedFunc(compare func(a, b Pair[K, V]) int) KVSeq[K, V] {
+	return func(yield Yielder2[K, V]) {
+		var sorted []Pair[K, V]
+		s(func(k K, v V) bool {
+			sorted = append(sorted, Pair[K, V]{k, v})
+			return true
+		})
+		slices.SortStableFunc(sorted, compare)
+		iterPairs(sorted)(yield)
+	}
+}
+
+// TopK restricts the iterator to its n largest elements according to compare,
+// yielded from largest to smallest. Elements that compare equal keep their
+// relative order from the input. Only n elements are held in memory at a
+// time. An n value < 1 yields an empty iterator.
+func (s Seq[V]) TopK(n int, compare func(a, b V) int) Seq[V] {
+	type indexed struct {
+		val V
+		idx int
+	}
+	return func(yield Yielder[V]) {
+		if n < 1 {
+			return
+		}
+		// The heap's smallest item is the first to be evicted, so among equal
+		// elements the later ones are treated as smaller.
+		h := NewHeap(func(a, b indexed) bool {
+			if c := compare(a.val, b.val); c != 0 {
+				return c < 0
+			}
+			return a.idx > b.idx
+		})
+		var idx int
+		s(func(v V) bool {
+			item := indexed{v, idx}
+			idx++
+			if h.Len() < n {
+				h.Push(item)
+			} else if compare(v, h.Peek().val) > 0 {
+				h.Pop()
+				h.Push(item)
+			}
+			return true
+		})
+		top := make([]V, h.Len())
+		for i := len(top) - 1; i >= 0; i-- {
+			top[i] = h.Pop().val
+		}
+		for _, v := range top {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
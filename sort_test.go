@@ -0,0 +1,79 @@
+package loz_test
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleSorted() {
+	sorted := loz.Sorted(loz.IterSlice([]int{3, 1, 4, 1, 5, 9, 2, 6})).
+		Take(4).
+		CollectSlice()
+	fmt.Print(sorted)
+	// Output: [1 1 2 3]
+}
+
+func ExampleSeq_SortedFunc() {
+	byLen := func(a, b string) int { return cmp.Compare(len(a), len(b)) }
+	sorted := loz.IterSlice([]string{"pear", "fig", "apple", "kiwi", "yam"}).
+		SortedFunc(byLen).
+		CollectSlice()
+	fmt.Print(sorted)
+	// Output: [fig yam pear kiwi apple]
+}
+
+func ExampleKVSeq_SortedByKey() {
+	loz.IterMap(map[string]int{"c": 3, "a": 1, "b": 2}).
+		SortedByKey(strings.Compare).
+		ForEach(func(k string, v int) {
+			fmt.Printf("%v: %v\n", k, v)
+		})
+	// Output: a: 1
+	// b: 2
+	// c: 3
+}
+
+func ExampleKVSeq_SortedByValue() {
+	loz.IterMap(map[string]int{"c": 1, "a": 3, "b": 2}).
+		SortedByValue(cmp.Compare[int]).
+		ForEach(func(k string, v int) {
+			fmt.Printf("%v: %v\n", k, v)
+		})
+	// Output: c: 1
+	// b: 2
+	// a: 3
+}
+
+func ExampleSeq_TopK() {
+	top := loz.IterSlice([]int{3, 1, 4, 1, 5, 9, 2, 6}).
+		TopK(3, cmp.Compare[int]).
+		CollectSlice()
+	fmt.Print(top)
+	// Output: [9 6 5]
+}
+
+func TestTopK(t *testing.T) {
+	type entry struct {
+		score int
+		name  string
+	}
+	entries := loz.IterSlice([]entry{{1, "a"}, {3, "b"}, {2, "c"}, {3, "d"}, {3, "e"}, {0, "f"}})
+	byScore := func(a, b entry) int { return cmp.Compare(a.score, b.score) }
+	assert.Equal(t, []entry{{3, "b"}, {3, "d"}}, entries.TopK(2, byScore).CollectSlice())
+	assert.Equal(t, []entry{{3, "b"}, {3, "d"}, {3, "e"}, {2, "c"}}, entries.TopK(4, byScore).CollectSlice())
+	assert.Len(t, entries.TopK(10, byScore).CollectSlice(), 6)
+	assert.Empty(t, entries.TopK(0, byScore).CollectSlice())
+}
+
+func TestSortedIsStable(t *testing.T) {
+	byFirst := func(a, b string) int { return cmp.Compare(a[0], b[0]) }
+	sorted := loz.IterSlice([]string{"b1", "a1", "b2", "a2", "b3"}).
+		SortedFunc(byFirst).
+		CollectSlice()
+	assert.Equal(t, []string{"a1", "a2", "b1", "b2", "b3"}, sorted)
+}
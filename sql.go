@@ -0,0 +1,29 @@
+package loz
+
+import (
+	"database/sql"
+
+	. "github.com/jmatth/loz/internal"
+)
+
+// Rows creates a Seq over the rows of a query result, converting each one with
+// scan. The rows are closed once the iteration completes, including when the
+// consumer stops early, such as after [Seq.Take] or [Seq.First]. If scan
+// returns an error, or rows.Err() reports one once the rows are exhausted, the
+// iteration is halted as if the error was passed to [PanicHaltIteration], so
+// the result should be consumed with a terminal method prefixed with "Try",
+// such as [Seq.TryCollectSlice], which will return the error. Since the rows
+// can only be read once, so can the resulting Seq.
+func Rows[V any](rows *sql.Rows, scan func(*sql.Rows) (V, error)) Seq[V] {
+	return func(yield Yielder[V]) {
+		defer rows.Close()
+		for rows.Next() {
+			v, err := scan(rows)
+			PanicHaltIteration(err)
+			if !yield(v) {
+				return
+			}
+		}
+		PanicHaltIteration(rows.Err())
+	}
+}
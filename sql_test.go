@@ -0,0 +1,136 @@
+package loz_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDriver serves queries of the form "count:N" or "count:N fail:M", which
+// return N rows with a single integer column, failing after M rows.
+type fakeDriver struct{}
+
+type fakeConn struct{}
+
+type fakeStmt struct{ query string }
+
+type fakeRows struct {
+	next, count, failAt int
+}
+
+var (
+	errFakeRows    = errors.New("fake rows error")
+	fakeRowsClosed atomic.Int32
+)
+
+func init() {
+	sql.Register("loz-fake", fakeDriver{})
+}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{query}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return -1 }
+func (fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	rows := &fakeRows{failAt: -1}
+	if _, err := fmt.Sscanf(s.query, "count:%d fail:%d", &rows.count, &rows.failAt); err != nil {
+		if _, err := fmt.Sscanf(s.query, "count:%d", &rows.count); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+func (r *fakeRows) Columns() []string { return []string{"n"} }
+
+func (r *fakeRows) Close() error {
+	fakeRowsClosed.Add(1)
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next == r.failAt {
+		return errFakeRows
+	}
+	if r.next >= r.count {
+		return io.EOF
+	}
+	dest[0] = int64(r.next)
+	r.next++
+	return nil
+}
+
+func openFakeDB(t testing.TB) *sql.DB {
+	db, err := sql.Open("loz-fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func scanInt(rows *sql.Rows) (n int, err error) {
+	err = rows.Scan(&n)
+	return n, err
+}
+
+func TestRows(t *testing.T) {
+	db := openFakeDB(t)
+	defer db.Close()
+	rows, err := db.Query("count:4")
+	assert.Nil(t, err)
+	nums, err := loz.Rows(rows, scanInt).
+		Map(func(n int) int { return n * 10 }).
+		TryCollectSlice()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 10, 20, 30}, nums)
+}
+
+func TestRowsClosedOnEarlyTermination(t *testing.T) {
+	db := openFakeDB(t)
+	defer db.Close()
+	rows, err := db.Query("count:100")
+	assert.Nil(t, err)
+	closedBefore := fakeRowsClosed.Load()
+	nums := loz.Rows(rows, scanInt).Take(2).CollectSlice()
+	assert.Equal(t, []int{0, 1}, nums)
+	assert.Equal(t, closedBefore+1, fakeRowsClosed.Load())
+	assert.False(t, rows.Next())
+}
+
+func TestRowsErrors(t *testing.T) {
+	db := openFakeDB(t)
+	defer db.Close()
+
+	rows, err := db.Query("count:5 fail:2")
+	assert.Nil(t, err)
+	var seen []int
+	err = loz.Rows(rows, scanInt).TryForEach(func(n int) {
+		seen = append(seen, n)
+	})
+	assert.Equal(t, []int{0, 1}, seen)
+	assert.Equal(t, errFakeRows, err)
+
+	scanErr := errors.New("Testing error")
+	rows, err = db.Query("count:5")
+	assert.Nil(t, err)
+	_, err = loz.Rows(rows, func(*sql.Rows) (int, error) {
+		return 0, scanErr
+	}).TryFirst()
+	assert.Equal(t, scanErr, err)
+	assert.False(t, rows.Next())
+}
@@ -0,0 +1,48 @@
+package loz
+
+import (
+	"iter"
+
+	. "github.com/jmatth/loz/internal"
+)
+
+// Zip creates a KVSeq that pairs the elements of a and b in order, advancing
+// both together. The iteration ends as soon as either input is exhausted. To
+// continue until both inputs are exhausted, see [ZipLongest].
+func Zip[A, B any](a Seq[A], b Seq[B]) KVSeq[A, B] {
+	return func(yield Yielder2[A, B]) {
+		nextA, stopA := iter.Pull(iter.Seq[A](a))
+		defer stopA()
+		nextB, stopB := iter.Pull(iter.Seq[B](b))
+		defer stopB()
+		for {
+			va, ok := nextA()
+			if !ok {
+				return
+			}
+			vb, ok := nextB()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+// ZipLongest is identical to [Zip], except the iteration continues until both
+// inputs are exhausted. Once one input is exhausted, its side of each pair is
+// padded with a zero value.
+func ZipLongest[A, B any](a Seq[A], b Seq[B]) KVSeq[A, B] {
+	return func(yield Yielder2[A, B]) {
+		nextA, stopA := iter.Pull(iter.Seq[A](a))
+		defer stopA()
+		nextB, stopB := iter.Pull(iter.Seq[B](b))
+		defer stopB()
+		for {
+			va, okA := nextA()
+			vb, okB := nextB()
+			if (!okA && !okB) || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package loz_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jmatth/loz"
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleZip() {
+	names := loz.IterSlice([]string{"root", "bin", "dbus"})
+	ids := loz.IterSlice([]int{0, 1, 81, 33})
+	loz.Zip(names, ids).ForEach(func(name string, id int) {
+		fmt.Printf("%v: %v\n", name, id)
+	})
+	// Output: root: 0
+	// bin: 1
+	// dbus: 81
+}
+
+func ExampleZipLongest() {
+	names := loz.IterSlice([]string{"root", "bin", "dbus"})
+	ids := loz.IterSlice([]int{0, 1, 81, 33})
+	loz.ZipLongest(names, ids).ForEach(func(name string, id int) {
+		fmt.Printf("%q: %v\n", name, id)
+	})
+	// Output: "root": 0
+	// "bin": 1
+	// "dbus": 81
+	// "": 33
+}
+
+func ExampleKVSeq_Unzip() {
+	keys, vals := iterKVPairs[int, string](1, "one", 2, "two", 3, "three").Unzip()
+	fmt.Printf("%v %v", keys.CollectSlice(), vals.CollectSlice())
+	// Output: [1 2 3] [one two three]
+}
+
+func TestZipStopsShortestWithoutOverreading(t *testing.T) {
+	pulled := 0
+	b := loz.Generate(10, func(idx int) int {
+		pulled++
+		return idx
+	})
+	keys := loz.Zip(loz.IterSlice([]string{"a", "b"}), b).Keys().CollectSlice()
+	assert.Equal(t, []string{"a", "b"}, keys)
+	assert.Equal(t, 2, pulled)
+
+	result, _ := loz.Zip(b, b).Take(2).Unzip()
+	assert.Equal(t, []int{0, 1}, result.CollectSlice())
+}
+
+func TestZipHalt(t *testing.T) {
+	haltingErr := errors.New("Testing error")
+	halting := loz.Generate(5, func(idx int) int {
+		if idx == 2 {
+			loz.PanicHaltIteration(haltingErr)
+		}
+		return idx
+	})
+	keys, vals, err := loz.Zip(halting, halting).TryUnzip()
+	assert.Nil(t, keys)
+	assert.Nil(t, vals)
+	assert.Equal(t, haltingErr, err)
+
+	err = loz.ZipLongest(loz.IterSlice([]int{1}), halting).TryForEach(func(int, int) {})
+	assert.Equal(t, haltingErr, err)
+}